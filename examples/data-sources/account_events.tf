@@ -0,0 +1,18 @@
+variable "service_id" {
+  type = string
+}
+
+# Who activated versions of a service in the last week of May?
+data "fastly_account_events" "activations" {
+  service_id     = var.service_id
+  event_type     = "version.activate"
+  created_after  = "2024-05-24T00:00:00Z"
+  created_before = "2024-06-01T00:00:00Z"
+}
+
+output "activations" {
+  value = [
+    for event in data.fastly_account_events.activations.events :
+    "${event.created_at} ${event.user_id}: ${event.description}"
+  ]
+}
@@ -0,0 +1,20 @@
+data "fastly_alert_definitions" "origins" {
+  source = "origins"
+}
+
+resource "fastly_alert" "origin_errors" {
+  for_each = toset([
+    for m in data.fastly_alert_definitions.origins.sources[0].metrics : m if startswith(m, "status_5")
+  ])
+
+  name       = "origin ${each.value}"
+  service_id = fastly_service_vcl.example.id
+  source     = "origins"
+  metric     = each.value
+
+  evaluation_strategy {
+    type      = "above_threshold"
+    period    = "5m"
+    threshold = 10
+  }
+}
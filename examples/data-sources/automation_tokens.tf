@@ -0,0 +1,12 @@
+resource "fastly_service_vcl" "example" {
+  #...
+}
+
+data "fastly_automation_tokens" "service" {
+  service_id = fastly_service_vcl.example.id
+  expired    = false
+}
+
+output "tokens_with_access_to_service" {
+  value = { for token in data.fastly_automation_tokens.service.tokens : token.name => token.scopes }
+}
@@ -0,0 +1,11 @@
+data "fastly_billing" "current" {
+  year  = 2024
+  month = 5
+}
+
+check "monthly_budget" {
+  assert {
+    condition     = data.fastly_billing.current.incurred_cost < 5000
+    error_message = "The Fastly bill for the month exceeds the budget."
+  }
+}
@@ -0,0 +1,8 @@
+data "fastly_configstore_entries" "routes" {
+  store_id   = "2eXampleC0nfigSt0reId"
+  key_prefix = "route/"
+}
+
+output "routes" {
+  value = data.fastly_configstore_entries.routes.entries
+}
@@ -0,0 +1,8 @@
+data "fastly_configstore_entry" "example" {
+  store_id = "2eXampleC0nfigSt0reId"
+  key      = "feature_flags"
+}
+
+output "feature_flags" {
+  value = jsondecode(data.fastly_configstore_entry.example.value)
+}
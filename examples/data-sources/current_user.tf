@@ -0,0 +1,5 @@
+data "fastly_current_user" "me" {}
+
+output "customer_id" {
+  value = data.fastly_current_user.me.customer_id
+}
@@ -0,0 +1,9 @@
+data "fastly_custom_dashboard" "example" {
+  id = "2Yk1dq1jYg1CkBUKhVdDlx"
+}
+
+# Write the dashboard to a file, to be used as `dashboard_json`.
+resource "local_file" "dashboard" {
+  filename = "${path.module}/dashboards/example.json"
+  content  = data.fastly_custom_dashboard.example.dashboard_json
+}
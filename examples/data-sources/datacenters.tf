@@ -9,3 +9,18 @@ output "fastly_datacenters_filtered" {
   # get the shield code of "TYO" POP
   value = one([for pop in data.fastly_datacenters.fastly.pops : pop.shield if pop["code"] == "TYO"])
 }
+
+# Pick the shield POP nearest to the origin.
+data "fastly_datacenters" "shields" {
+  group       = "Europe"
+  shield_only = true
+
+  near {
+    latitude  = 50.85
+    longitude = 4.35
+  }
+}
+
+output "nearest_shield" {
+  value = data.fastly_datacenters.shields.pops[0].shield
+}
@@ -0,0 +1,9 @@
+# All the domains under example.com that are not associated with a service.
+data "fastly_domains_v1" "unassociated" {
+  fqdn               = "example.com"
+  association_status = "unassociated"
+}
+
+output "unassociated_domains" {
+  value = data.fastly_domains_v1.unassociated.domains[*].fqdn
+}
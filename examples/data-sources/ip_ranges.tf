@@ -14,4 +14,29 @@ resource "aws_security_group" "from_fastly" {
     cidr_blocks       = data.fastly_ip_ranges.fastly.cidr_blocks
     ipv6_cidr_blocks  = data.fastly_ip_ranges.fastly.ipv6_cidr_blocks
   }
-}
\ No newline at end of file
+}
+
+# Aggregated ipv4 blocks, for firewalls limiting the number of rules.
+data "fastly_ip_ranges" "fastly_ipv4" {
+  address_family = "ipv4"
+  aggregate      = true
+}
+
+resource "aws_ec2_managed_prefix_list" "fastly" {
+  name           = "fastly-ipv4"
+  address_family = "IPv4"
+  max_entries    = length(data.fastly_ip_ranges.fastly_ipv4.cidr_blocks)
+
+  dynamic "entry" {
+    for_each = data.fastly_ip_ranges.fastly_ipv4.aws_prefix_list_entries
+    content {
+      cidr        = entry.key
+      description = entry.value
+    }
+  }
+}
+
+resource "local_file" "nginx_allow" {
+  filename = "${path.module}/fastly-allow.conf"
+  content  = data.fastly_ip_ranges.fastly.nginx_allow
+}
@@ -0,0 +1,11 @@
+data "fastly_kvstore_entry" "example" {
+  store_id = "2eXampleKVSt0reId"
+  key      = "release"
+}
+
+# Binary values can be read as base64.
+data "fastly_kvstore_entry" "certificate" {
+  store_id = "2eXampleKVSt0reId"
+  key      = "certificate.der"
+  encoding = "base64"
+}
@@ -0,0 +1,14 @@
+data "fastly_package_archive" "example" {
+  source_dir  = "./path/to/project"
+  output_path = "./pkg/package.tar.gz"
+  excludes    = ["src", "target"]
+}
+
+resource "fastly_service_compute" "example" {
+  # ...
+
+  package {
+    filename         = data.fastly_package_archive.example.output_path
+    source_code_hash = data.fastly_package_archive.example.hash
+  }
+}
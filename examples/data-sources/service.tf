@@ -0,0 +1,18 @@
+# Read the active version of a service owned by another team.
+data "fastly_service" "shared" {
+  service_id = "SU1Z0isxPaozGVKXdv0eY"
+}
+
+output "shared_domains" {
+  value = [for domain in data.fastly_service.shared.domain : domain.name]
+}
+
+output "shared_dictionary_ids" {
+  value = { for dictionary in data.fastly_service.shared.dictionary : dictionary.name => dictionary.dictionary_id }
+}
+
+# A specific version can be read instead of the active one.
+data "fastly_service" "shared_v3" {
+  service_id = "SU1Z0isxPaozGVKXdv0eY"
+  version    = 3
+}
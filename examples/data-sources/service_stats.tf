@@ -0,0 +1,19 @@
+data "fastly_service_stats" "example" {
+  service_id = var.service_id
+  from       = "4 weeks ago"
+  to         = "now"
+  by         = "hour"
+  fields     = ["requests", "bandwidth", "status_5xx"]
+}
+
+locals {
+  stats = { for s in data.fastly_service_stats.example.stats : s.field => s }
+}
+
+output "p95_requests_per_second" {
+  value = local.stats["requests"].p95 / 3600
+}
+
+output "average_5xx_per_hour" {
+  value = local.stats["status_5xx"].average
+}
@@ -8,3 +8,18 @@ output "fastly_services_filtered" {
   # get the service with the name "Example Service"
   value = one([for service in data.fastly_services.services.details : service.id if service.name == "Example Service"])
 }
+
+# Find the production VCL services with a draft version newer than the active one.
+data "fastly_services" "production" {
+  name_regex         = "-prod$"
+  type               = "vcl"
+  has_active_version = true
+  include_versions   = true
+}
+
+output "fastly_services_with_drafts" {
+  value = [
+    for service in data.fastly_services.production.details : service.name
+    if anytrue([for version in service.versions : version.number > service.version && !version.locked])
+  ]
+}
@@ -0,0 +1,15 @@
+data "fastly_tokens" "stale" {
+  never_used = true
+  expired    = false
+}
+
+output "never_used_tokens" {
+  value = [for token in data.fastly_tokens.stale.tokens : "${token.name} (${token.user_id})"]
+}
+
+check "no_stale_tokens" {
+  assert {
+    condition     = length(data.fastly_tokens.stale.ids) == 0
+    error_message = "There are unexpired tokens that have never been used."
+  }
+}
@@ -0,0 +1,18 @@
+data "fastly_usage" "last_week" {
+  from = "1 week ago"
+  to   = "now"
+}
+
+# Fail the plan if any service used more than 10 TB in the last week.
+check "bandwidth_budget" {
+  assert {
+    condition = alltrue([
+      for service in data.fastly_usage.last_week.services : service.bandwidth < 10 * pow(1000, 4)
+    ])
+    error_message = "A service exceeded its weekly bandwidth budget."
+  }
+}
+
+output "requests_per_region" {
+  value = { for region in data.fastly_usage.last_week.regions : region.region => region.requests }
+}
@@ -0,0 +1,13 @@
+data "fastly_users" "all" {}
+
+data "fastly_users" "superusers" {
+  role = "superuser"
+}
+
+output "superuser_logins" {
+  value = [for user in data.fastly_users.superusers.users : user.login]
+}
+
+output "users_without_2fa" {
+  value = [for user in data.fastly_users.all.users : user.login if !user.two_factor_auth_enabled]
+}
@@ -0,0 +1,19 @@
+resource "fastly_integration" "example" {
+  name = "my_integration"
+  # ...
+}
+
+resource "fastly_alert_policy" "example" {
+  name               = "5xx errors"
+  service_name_regex = "^prod-"
+  source             = "stats"
+  metric             = "status_5xx"
+
+  evaluation_strategy {
+    type      = "above_threshold"
+    period    = "5m"
+    threshold = 10
+  }
+
+  integration_ids = [fastly_integration.example.id]
+}
@@ -0,0 +1,13 @@
+variable "fastly_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "fastly_api_token" "purge" {
+  name     = "purge-only"
+  username = "user@example.com"
+  password = var.fastly_password
+  scopes   = ["purge_select", "purge_all"]
+
+  expires_at = "2026-01-01T00:00:00Z"
+}
@@ -0,0 +1,27 @@
+resource "fastly_service_vcl" "demo" {
+  #...
+}
+
+variable "fastly_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "fastly_automation_token" "ci" {
+  name     = "ci-deployments"
+  username = "admin@example.com"
+  password = var.fastly_password
+  role     = "engineer"
+  scopes   = ["global"]
+  services = [fastly_service_vcl.demo.id]
+
+  # The token expires 90 days after it is created and is replaced on the
+  # first apply within 14 days of its expiry.
+  ttl           = "2160h"
+  rotate_before = "336h"
+}
+
+output "ci_token" {
+  value     = fastly_automation_token.ci.access_token
+  sensitive = true
+}
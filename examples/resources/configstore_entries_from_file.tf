@@ -0,0 +1,10 @@
+resource "fastly_configstore" "example" {
+  name = "routing"
+}
+
+# routes.csv contains one `key,value` pair per line.
+resource "fastly_configstore_entries" "example" {
+  store_id       = fastly_configstore.example.id
+  entries_file   = "${path.module}/routes.csv"
+  manage_entries = true
+}
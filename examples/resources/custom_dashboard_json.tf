@@ -0,0 +1,7 @@
+# Designed in the Fastly Control Panel and exported with the
+# `fastly_custom_dashboard` data source (or the API).
+resource "fastly_custom_dashboard" "example" {
+  name           = "Example Custom Dashboard"
+  description    = "This is an example custom dashboard"
+  dashboard_json = file("${path.module}/dashboards/example.json")
+}
@@ -0,0 +1,34 @@
+variable "live" {
+  type    = string
+  default = "blue"
+}
+
+resource "fastly_service_vcl" "blue" {
+  name = "blue"
+
+  domain {
+    name = "www.example.com"
+  }
+
+  force_destroy = true
+}
+
+resource "fastly_service_vcl" "green" {
+  name = "green"
+
+  domain {
+    name = "www.example.com"
+  }
+
+  force_destroy = true
+}
+
+data "fastly_domains_v1" "example" {
+  fqdn = "example.com"
+}
+
+# Changing `live` moves all the domains to the other service in one apply.
+resource "fastly_domain_v1_service_link" "live" {
+  service_id = var.live == "blue" ? fastly_service_vcl.blue.id : fastly_service_vcl.green.id
+  domain_ids = data.fastly_domains_v1.example.ids
+}
@@ -0,0 +1,30 @@
+resource "fastly_integration" "pagerduty_example" {
+  name        = "my PagerDuty integration"
+  description = "example PagerDuty integration"
+  type        = "pagerduty"
+
+  pagerduty {
+    integration_key = var.pagerduty_integration_key
+  }
+}
+
+resource "fastly_integration" "newrelic_example" {
+  name        = "my New Relic integration"
+  description = "example New Relic integration"
+  type        = "newrelic"
+
+  newrelic {
+    account_id  = "XXXXXXX"
+    license_key = var.newrelic_license_key
+  }
+}
+
+resource "fastly_integration" "webhook_example" {
+  name        = "my webhook integration"
+  description = "example webhook integration"
+  type        = "webhook"
+
+  webhook {
+    url = "https://my.domain.com/webhook"
+  }
+}
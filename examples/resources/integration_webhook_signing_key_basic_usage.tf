@@ -0,0 +1,26 @@
+resource "fastly_integration" "example" {
+  name = "my webhook integration"
+  type = "webhook"
+
+  webhook {
+    url = "https://my.domain.com/webhook"
+  }
+}
+
+resource "time_rotating" "signing_key" {
+  rotation_days = 90
+}
+
+resource "fastly_integration_webhook_signing_key" "example" {
+  integration_id = fastly_integration.example.id
+
+  # Rotate the signing key every 90 days.
+  triggers = {
+    rotation = time_rotating.signing_key.id
+  }
+}
+
+output "webhook_signing_key" {
+  value     = fastly_integration_webhook_signing_key.example.signing_key
+  sensitive = true
+}
@@ -0,0 +1,12 @@
+resource "fastly_service_vcl" "demo" {
+  #...
+}
+
+resource "fastly_purge" "everything" {
+  service_id = fastly_service_vcl.demo.id
+  purge_all  = true
+
+  triggers = {
+    active_version = fastly_service_vcl.demo.active_version
+  }
+}
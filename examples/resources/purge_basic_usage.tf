@@ -0,0 +1,14 @@
+resource "fastly_service_vcl" "demo" {
+  #...
+}
+
+resource "fastly_purge" "after_deploy" {
+  service_id     = fastly_service_vcl.demo.id
+  surrogate_keys = ["product-listing", "homepage"]
+  urls           = ["https://www.example.com/index.html"]
+  soft           = true
+
+  triggers = {
+    active_version = fastly_service_vcl.demo.active_version
+  }
+}
@@ -0,0 +1,14 @@
+#...
+
+resource "fastly_service_acl_entries" "entries" {
+  for_each = {
+    for d in fastly_service_vcl.myservice.acl : d.name => d if d.name == var.myacl_name
+  }
+  service_id     = fastly_service_vcl.myservice.id
+  acl_id         = each.value.acl_id
+  manage_entries = true
+  cidrs = concat(
+    split("\n", trimspace(file("${path.module}/allowlist.txt"))),
+    ["!192.0.2.1"],
+  )
+}
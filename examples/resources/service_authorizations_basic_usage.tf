@@ -0,0 +1,30 @@
+resource "fastly_service_vcl" "demo" {
+  name = "demofastly"
+
+  domain {
+    name    = "demo.notexample.com"
+    comment = "demo"
+  }
+
+  force_destroy = true
+}
+
+data "fastly_users" "engineers" {
+  role = "engineer"
+}
+
+# Engineers can purge, the on-call engineer has full access, and every other
+# grant on the service is removed.
+resource "fastly_service_authorizations" "team" {
+  service_id    = fastly_service_vcl.demo.id
+  authoritative = true
+
+  permissions = merge(
+    { for id in data.fastly_users.engineers.ids : id => "purge_all" },
+    { (var.on_call_user_id) = "full" },
+  )
+}
+
+variable "on_call_user_id" {
+  type = string
+}
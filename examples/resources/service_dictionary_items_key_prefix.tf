@@ -0,0 +1,14 @@
+#...
+
+resource "fastly_service_dictionary_items" "team_a" {
+  for_each = {
+    for d in fastly_service_vcl.myservice.dictionary : d.name => d if d.name == var.mydict_name
+  }
+  service_id    = fastly_service_vcl.myservice.id
+  dictionary_id = each.value.dictionary_id
+  key_prefix    = "team_a_"
+  items = {
+    team_a_key1 : "value1"
+    team_a_key2 : "value2"
+  }
+}
@@ -0,0 +1,192 @@
+package fastly
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// alertDefinitionsJSON is the catalogue of alert sources, with their metrics
+// and dimensions, and of the evaluation strategies. The API does not expose
+// it, so it is maintained by hand from the Fastly alerts documentation and
+// may lag behind the API: metrics missing from it are only warned about.
+//
+//go:embed alert_definitions.json
+var alertDefinitionsJSON []byte
+
+// alertDefinitions is the parsed alert catalogue.
+var alertDefinitions = mustParseAlertDefinitions(alertDefinitionsJSON)
+
+type alertCatalogue struct {
+	EvaluationStrategyTypes []alertCatalogueItem   `json:"evaluation_strategy_types"`
+	Periods                 []string               `json:"periods"`
+	Sources                 []alertCatalogueSource `json:"sources"`
+}
+
+type alertCatalogueItem struct {
+	Description string `json:"description"`
+	Name        string `json:"name"`
+}
+
+type alertCatalogueSource struct {
+	Description       string   `json:"description"`
+	Dimensions        []string `json:"dimensions"`
+	Metrics           []string `json:"metrics"`
+	Name              string   `json:"name"`
+	RequiresServiceID bool     `json:"requires_service_id"`
+}
+
+func mustParseAlertDefinitions(data []byte) *alertCatalogue {
+	var c alertCatalogue
+	if err := json.Unmarshal(data, &c); err != nil {
+		panic(fmt.Sprintf("invalid alert catalogue: %s", err))
+	}
+	return &c
+}
+
+// source returns the catalogue entry of a source, or nil if it is unknown.
+func (c *alertCatalogue) source(name string) *alertCatalogueSource {
+	for i := range c.Sources {
+		if c.Sources[i].Name == name {
+			return &c.Sources[i]
+		}
+	}
+	return nil
+}
+
+func (c *alertCatalogue) sourceNames() []string {
+	names := make([]string, 0, len(c.Sources))
+	for _, s := range c.Sources {
+		names = append(names, s.Name)
+	}
+	return names
+}
+
+func (c *alertCatalogue) evaluationStrategyTypeNames() []string {
+	names := make([]string, 0, len(c.EvaluationStrategyTypes))
+	for _, t := range c.EvaluationStrategyTypes {
+		names = append(names, t.Name)
+	}
+	return names
+}
+
+// alertDefinitionParts holds the attributes of an alert checked against the
+// catalogue. Empty values are not known yet and are not checked.
+type alertDefinitionParts struct {
+	Dimensions   []string
+	Metric       string
+	Period       string
+	Source       string
+	StrategyType string
+}
+
+// check validates the combination of source, dimensions and evaluation
+// strategy against the catalogue. Metrics are checked by metricWarning.
+func (c *alertCatalogue) check(p alertDefinitionParts) error {
+	if p.StrategyType != "" && !slices.Contains(c.evaluationStrategyTypeNames(), p.StrategyType) {
+		return fmt.Errorf("unknown evaluation_strategy type %q, expected one of: %s", p.StrategyType, strings.Join(c.evaluationStrategyTypeNames(), ", "))
+	}
+	if p.Period != "" && !slices.Contains(c.Periods, p.Period) {
+		return fmt.Errorf("unknown evaluation_strategy period %q, expected one of: %s", p.Period, strings.Join(c.Periods, ", "))
+	}
+
+	if p.Source == "" {
+		return nil
+	}
+	source := c.source(p.Source)
+	if source == nil {
+		return fmt.Errorf("unknown source %q, expected one of: %s", p.Source, strings.Join(c.sourceNames(), ", "))
+	}
+	for _, dimension := range p.Dimensions {
+		if !slices.Contains(source.Dimensions, dimension) {
+			if len(source.Dimensions) == 0 {
+				return fmt.Errorf("source %q does not support dimensions, remove `%s`", p.Source, dimension)
+			}
+			return fmt.Errorf("dimension `%s` is not available for source %q, expected one of: %s", dimension, p.Source, strings.Join(source.Dimensions, ", "))
+		}
+	}
+
+	return nil
+}
+
+// metricWarning returns a warning if the metric is not in the catalogue for
+// a known source, or an empty string otherwise.
+func (c *alertCatalogue) metricWarning(sourceName, metric string) string {
+	source := c.source(sourceName)
+	if source == nil || metric == "" || slices.Contains(source.Metrics, metric) {
+		return ""
+	}
+	return fmt.Sprintf("metric %q is not in the provider's catalogue for source %q (%s), check that the API supports it", metric, sourceName, strings.Join(source.Metrics, ", "))
+}
+
+// alertMetricDiags returns the metric warning of an alert as diagnostics.
+func alertMetricDiags(d interface{ Get(string) any }) diag.Diagnostics {
+	w := alertDefinitions.metricWarning(d.Get("source").(string), d.Get("metric").(string))
+	if w == "" {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Unknown alert metric",
+		Detail:   w,
+	}}
+}
+
+// alertDefinitionPartsFromDiff reads the attributes checked against the
+// catalogue from a plan, leaving out values that are not known yet.
+func alertDefinitionPartsFromDiff(d *schema.ResourceDiff) alertDefinitionParts {
+	var p alertDefinitionParts
+	if d.NewValueKnown("source") {
+		p.Source = d.Get("source").(string)
+	}
+	if d.NewValueKnown("metric") {
+		p.Metric = d.Get("metric").(string)
+	}
+	if d.NewValueKnown("evaluation_strategy.0.type") {
+		p.StrategyType = d.Get("evaluation_strategy.0.type").(string)
+	}
+	if d.NewValueKnown("evaluation_strategy.0.period") {
+		p.Period = d.Get("evaluation_strategy.0.period").(string)
+	}
+	for _, r := range d.Get("dimensions").([]any) {
+		m, ok := r.(map[string]any)
+		if !ok {
+			continue
+		}
+		for _, dimension := range sortedKeys(m) {
+			if s, ok := m[dimension].(*schema.Set); ok && s.Len() > 0 {
+				p.Dimensions = append(p.Dimensions, dimension)
+			}
+		}
+	}
+	return p
+}
+
+// validateAlertDefinition is a CustomizeDiff function rejecting combinations of
+// source, dimensions and evaluation strategy that the API does not accept.
+// The SDK does not support returning warnings from a CustomizeDiff, so unknown
+// metrics are only logged here, and returned as diagnostics when the alert is
+// applied (see alertMetricDiags).
+func validateAlertDefinition(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	p := alertDefinitionPartsFromDiff(d)
+	if w := alertDefinitions.metricWarning(p.Source, p.Metric); w != "" {
+		log.Printf("[WARN] %s", w)
+	}
+	return alertDefinitions.check(p)
+}
+
+// validateAlertServiceID is a CustomizeDiff function rejecting alerts without
+// a service for sources that require one.
+func validateAlertServiceID(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.NewValueKnown("source") || !d.NewValueKnown("service_id") {
+		return nil
+	}
+	return validateSourceWithServiceId(d.Get("source").(string), d.Get("service_id").(string))
+}
@@ -0,0 +1,135 @@
+package fastly
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAlertDefinitionsCatalogue(t *testing.T) {
+	seen := map[string]bool{}
+	for _, s := range alertDefinitions.Sources {
+		if seen[s.Name] {
+			t.Errorf("duplicate source %q", s.Name)
+		}
+		seen[s.Name] = true
+		if len(s.Metrics) == 0 {
+			t.Errorf("source %q has no metrics", s.Name)
+		}
+	}
+	for _, name := range []string{"domains", "origins", "stats"} {
+		if alertDefinitions.source(name) == nil {
+			t.Errorf("missing source %q", name)
+		}
+	}
+	if len(alertDefinitions.EvaluationStrategyTypes) == 0 || len(alertDefinitions.Periods) == 0 {
+		t.Errorf("missing evaluation strategies")
+	}
+}
+
+func TestAlertCatalogueCheck(t *testing.T) {
+	for name, testcase := range map[string]struct {
+		parts   alertDefinitionParts
+		wantErr string
+	}{
+		"valid stats": {
+			parts: alertDefinitionParts{Source: "stats", Metric: "status_5xx", StrategyType: "above_threshold", Period: "5m"},
+		},
+		"valid domains with dimension": {
+			parts: alertDefinitionParts{Source: "domains", Metric: "status_4xx", Dimensions: []string{"domains"}},
+		},
+		"unknown values are skipped": {
+			parts: alertDefinitionParts{},
+		},
+		"unknown source": {
+			parts:   alertDefinitionParts{Source: "logs", Metric: "status_5xx"},
+			wantErr: `unknown source "logs"`,
+		},
+		"metric missing from the catalogue is not rejected": {
+			parts: alertDefinitionParts{Source: "origins", Metric: "hit_ratio"},
+		},
+		"dimension not available for source": {
+			parts:   alertDefinitionParts{Source: "domains", Metric: "status_4xx", Dimensions: []string{"origins"}},
+			wantErr: "dimension `origins` is not available",
+		},
+		"dimensions not supported": {
+			parts:   alertDefinitionParts{Source: "stats", Metric: "status_4xx", Dimensions: []string{"domains"}},
+			wantErr: `source "stats" does not support dimensions`,
+		},
+		"unknown strategy type": {
+			parts:   alertDefinitionParts{StrategyType: "above"},
+			wantErr: `unknown evaluation_strategy type "above"`,
+		},
+		"unknown period": {
+			parts:   alertDefinitionParts{Period: "10m"},
+			wantErr: `unknown evaluation_strategy period "10m"`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := alertDefinitions.check(testcase.parts)
+			if testcase.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), testcase.wantErr) {
+				t.Errorf("want error containing %q, got %v", testcase.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestAlertMetricDiags(t *testing.T) {
+	for name, testcase := range map[string]struct {
+		source, metric string
+		wantWarning    bool
+	}{
+		"known metric":   {source: "stats", metric: "status_5xx"},
+		"unknown metric": {source: "origins", metric: "hit_ratio", wantWarning: true},
+		"unknown source": {source: "logs", metric: "hit_ratio"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceFastlyAlert().Schema, map[string]any{
+				"metric": testcase.metric,
+				"source": testcase.source,
+			})
+			diags := alertMetricDiags(d)
+			if !testcase.wantWarning {
+				if len(diags) != 0 {
+					t.Errorf("unexpected diagnostics: %v", diags)
+				}
+				return
+			}
+			if len(diags) != 1 || diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Detail, `metric "hit_ratio"`) {
+				t.Errorf("want a single warning about the metric, got %v", diags)
+			}
+		})
+	}
+}
+
+func TestValidateSourceWithServiceId(t *testing.T) {
+	for _, testcase := range []struct {
+		source, serviceID string
+		wantErr           string
+	}{
+		{source: "stats"},
+		{source: "domains", serviceID: "abc"},
+		{source: "domains", wantErr: badAlertSourceServiceIdConfig},
+		{source: "origins", wantErr: badAlertSourceServiceIdConfig},
+		{source: "logs", wantErr: `unknown source "logs"`},
+	} {
+		err := validateSourceWithServiceId(testcase.source, testcase.serviceID)
+		if testcase.wantErr == "" {
+			if err != nil {
+				t.Errorf("source %q, service %q: unexpected error: %v", testcase.source, testcase.serviceID, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), testcase.wantErr) {
+			t.Errorf("source %q, service %q: want error containing %q, got %v", testcase.source, testcase.serviceID, testcase.wantErr, err)
+		}
+	}
+}
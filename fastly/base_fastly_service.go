@@ -63,11 +63,14 @@ func resourceService(serviceDef ServiceDefinition) *schema.Resource {
 			customdiff.ComputedIf("cloned_version", func(_ context.Context, d *schema.ResourceDiff, _ any) bool {
 				// If anything other than name, comment and version_comment has changed, the current version will be
 				// cloned in resourceServiceUpdate so set it as recomputed. These three fields can be updated without
-				// creating a new version
+				// creating a new version. The purge_on_activate block only affects what happens after an activation.
 				for _, changedKey := range d.GetChangedKeysPrefix("") {
 					if changedKey == "name" || changedKey == "comment" || changedKey == "version_comment" {
 						continue
 					}
+					if strings.HasPrefix(changedKey, "purge_on_activate") {
+						continue
+					}
 					return true
 				}
 				return false
@@ -80,6 +83,7 @@ func resourceService(serviceDef ServiceDefinition) *schema.Resource {
 			validateUniqueNames("backend"),
 			validateUniqueNames("rate_limiter"),
 			validateUniqueNames("snippet"),
+			validateShields,
 		),
 		Schema: map[string]*schema.Schema{
 			"activate": {
@@ -129,11 +133,50 @@ func resourceService(serviceDef ServiceDefinition) *schema.Resource {
 				Computed:    true,
 				Description: "Used internally by the provider to temporarily indicate if the service is being imported, and is reset to false once the import is finished",
 			},
+			"last_external_change": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The most recent activation of a version of the Service outside of Terraform, e.g. in the Fastly UI. Recorded when the active version is found to differ from the version last activated by Terraform, from the most recent activation events only. Requires a token that can read the account events.",
+				Elem: &schema.Resource{
+					Schema: accountEventSchema(),
+				},
+			},
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
 				Description: "The unique name for the Service to create",
 			},
+			"purge_on_activate": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Purges cached content after a new service version has been successfully activated. The purge is skipped when no new version is activated (e.g. only `name` or `comment` changed) and when the service is first created.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"all": {
+							Type:          schema.TypeBool,
+							Optional:      true,
+							Default:       false,
+							Description:   "Purge all cached content for the service. Conflicts with `surrogate_keys`. Default `false`",
+							ConflictsWith: []string{"purge_on_activate.0.surrogate_keys"},
+						},
+						"soft": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Mark the purged content as stale instead of removing it from the cache. Only applies to `surrogate_keys`. Default `false`",
+						},
+						"surrogate_keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Surrogate keys to purge after each activation.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
 			"reuse": {
 				Type:          schema.TypeBool,
 				Optional:      true,
@@ -298,6 +341,8 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta any
 
 	conn := meta.(*APIClient).conn
 
+	var diags diag.Diagnostics
+
 	shouldActivate := d.Get("activate").(bool)
 	// Update Name and/or Comment. No new version is required for this.
 	if d.HasChanges("name", "comment") && shouldActivate {
@@ -437,6 +482,10 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta any
 		if err != nil {
 			return diag.FromErr(err)
 		}
+
+		if serviceDef.GetType() == ServiceTypeCompute && d.HasChanges("package", "backend", "resource_link") {
+			diags = append(diags, packageManifestDiags(d)...)
+		}
 	}
 
 	versionNotYetActivated := d.Get("cloned_version") != d.Get("active_version")
@@ -457,6 +506,19 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta any
 		if err != nil {
 			return diag.FromErr(err)
 		}
+
+		// A brand new service has nothing cached, so there is nothing to purge.
+		if !d.IsNewResource() {
+			if err := purgeOnActivate(d, conn); err != nil {
+				// The version is already active, so a failed purge must not fail
+				// the apply (it would not be retried as there is no diff left).
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("Version (%d) was activated but the purge failed", latestVersion),
+					Detail:   err.Error(),
+				})
+			}
+		}
 	} else {
 		log.Printf("[INFO] Skipping activation of Fastly Service (%s), Version (%v)", d.Id(), latestVersion)
 		log.Print("[INFO] The Terraform definition is explicitly specified to not activate the changes on Fastly")
@@ -464,7 +526,38 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta any
 		log.Printf("[INFO] Visit https://manage.fastly.com/configure/services/%s/versions/%v and activate it manually", d.Id(), latestVersion)
 	}
 
-	return resourceServiceRead(ctx, d, meta, serviceDef)
+	return append(diags, resourceServiceRead(ctx, d, meta, serviceDef)...)
+}
+
+// purgeOnActivate performs the purge configured in the purge_on_activate block
+// (if any) after a new service version has been activated.
+func purgeOnActivate(d *schema.ResourceData, conn *gofastly.Client) error {
+	v, ok := d.GetOk("purge_on_activate")
+	if !ok {
+		return nil
+	}
+	l := v.([]any)
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	config := l[0].(map[string]any)
+
+	if config["all"].(bool) {
+		log.Printf("[DEBUG] Purging all content from Fastly Service (%s) after activation", d.Id())
+		_, err := conn.PurgeAll(&gofastly.PurgeAllInput{
+			ServiceID: d.Id(),
+		})
+		return err
+	}
+
+	keys := buildStringSlice(config["surrogate_keys"].(*schema.Set))
+	if len(keys) == 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Purging %d surrogate key(s) from Fastly Service (%s) after activation", len(keys), d.Id())
+	_, err := purgeSurrogateKeys(conn, d.Id(), keys, config["soft"].(bool))
+	return err
 }
 
 // resourceServiceRead provides service resource Read functionality.
@@ -553,6 +646,12 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta any,
 			if err != nil {
 				return diag.FromErr(err)
 			}
+
+			// The prior state is empty when the service is being created or
+			// imported, which is not drift.
+			if activeVersionFromPriorState != 0 {
+				setLastExternalChange(d, conn, activeVersionFromPriorState)
+			}
 		}
 		err = d.Set("active_version", s.ActiveVersion.Number)
 		if err != nil {
@@ -646,6 +745,25 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta any,
 	return diags
 }
 
+// setLastExternalChange records the most recent activation event of the
+// service in last_external_change, leaving out the activations of the versions
+// Terraform activated or cloned. A failure to read the events, e.g. because
+// the token is not allowed to, is logged and does not fail the refresh.
+func setLastExternalChange(d *schema.ResourceData, conn *gofastly.Client, activeVersionFromPriorState int) {
+	event, err := getLastActivationEvent(conn, d.Id(), activeVersionFromPriorState, d.Get("cloned_version").(int))
+	if err != nil {
+		log.Printf("[WARN] Unable to look up the last external change of Service (%s): %s", d.Id(), err)
+		return
+	}
+	if event == nil {
+		return
+	}
+	log.Printf("[DEBUG] Service (%s) was last activated outside of Terraform by user (%s) at %s", d.Id(), event.UserID, formatRFC3339Time(event.CreatedAt))
+	if err := d.Set("last_external_change", []map[string]any{flattenAccountEvent(event)}); err != nil {
+		log.Printf("[WARN] Error setting last_external_change for (%s): %s", d.Id(), err)
+	}
+}
+
 // resourceServiceDelete provides service resource Delete functionality.
 func resourceServiceDelete(_ context.Context, d *schema.ResourceData, meta any, _ ServiceDefinition) diag.Diagnostics {
 	conn := meta.(*APIClient).conn
@@ -1,12 +1,21 @@
 package fastly
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha512"
 	"encoding/base64"
+	"errors"
 	"fmt"
+	"io"
 	"log"
+	"os"
+	"slices"
+	"sort"
+	"strings"
 
 	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -69,18 +78,46 @@ func (h *PackageServiceAttributeHandler) Process(_ context.Context, d *schema.Re
 		// Schema guarantees one package block.
 		pkg := v.([]any)[0].(map[string]any)
 
+		var data []byte
 		if v := pkg["content"].(string); v != "" {
 			decoded, err := base64.StdEncoding.DecodeString(v)
 			if err != nil {
 				return fmt.Errorf("error decoding base64 string for package %s: %s", d.Id(), err)
 			}
 			input.PackageContent = []byte(decoded)
+			data = decoded
 		}
 		if v := pkg["filename"].(string); v != "" {
 			input.PackagePath = gofastly.ToPointer(v)
+
+			// G304 (CWE-22): Potential file inclusion via variable
+			// #nosec
+			b, err := os.ReadFile(v)
+			if err != nil {
+				return fmt.Errorf("error reading package %s: %s", v, err)
+			}
+			data = b
+		}
+
+		hashSum, filesHash, err := validatePackage(data)
+		if err != nil {
+			return fmt.Errorf("invalid package for %s: %s", d.Id(), err)
+		}
+
+		// The cloned version carries the package of the version it was cloned
+		// from, so an upload is only needed if the package has changed.
+		remoteState, err := conn.GetPackage(&gofastly.GetPackageInput{
+			ServiceID:      d.Id(),
+			ServiceVersion: latestVersion,
+		})
+		if err != nil {
+			log.Printf("[DEBUG] Unable to look up Package for (%s), version (%v), uploading: %s", d.Id(), latestVersion, err)
+		} else if packageMatches(remoteState, hashSum, filesHash) {
+			log.Printf("[DEBUG] Package for (%s), version (%v) is unchanged, skipping upload", d.Id(), latestVersion)
+			return nil
 		}
 
-		_, err := conn.UpdatePackage(input)
+		_, err = conn.UpdatePackage(input)
 		if err != nil {
 			return fmt.Errorf("error modifying package %s: %s", d.Id(), err)
 		}
@@ -89,6 +126,55 @@ func (h *PackageServiceAttributeHandler) Process(_ context.Context, d *schema.Re
 	return nil
 }
 
+// validatePackage checks the size and layout of a package before it is
+// uploaded, and returns the SHA512 hash of the package and the hash of the
+// files within it (as computed by getFilesHash).
+func validatePackage(data []byte) (hashSum, filesHash string, err error) {
+	if int64(len(data)) > maxPackageSize {
+		return "", "", errors.New("package size exceeded 100MB limit")
+	}
+
+	files, err := readPackageFiles(bytes.NewReader(data))
+	if err != nil {
+		return "", "", err
+	}
+
+	var hasMain bool
+	for name := range files {
+		// The package may have a top-level directory, e.g. `name/bin/main.wasm`.
+		name = strings.TrimPrefix(name, "./")
+		if name == "bin/main.wasm" || (strings.HasSuffix(name, "/bin/main.wasm") && strings.Count(name, "/") == 2) {
+			hasMain = true
+			break
+		}
+	}
+	if !hasMain {
+		return "", "", errors.New("package does not contain bin/main.wasm")
+	}
+
+	filesHash, err = getFilesHash(files)
+	if err != nil {
+		return "", "", err
+	}
+
+	return fmt.Sprintf("%x", sha512.Sum512(data)), filesHash, nil
+}
+
+// packageMatches reports whether the package returned by the API has the
+// given hashes.
+func packageMatches(remoteState *gofastly.Package, hashSum, filesHash string) bool {
+	if remoteState == nil || remoteState.Metadata == nil {
+		return false
+	}
+	if v := remoteState.Metadata.HashSum; v != nil && *v == hashSum {
+		return true
+	}
+	if v := remoteState.Metadata.FilesHash; v != nil && *v == filesHash {
+		return true
+	}
+	return false
+}
+
 type PkgType int64
 
 const (
@@ -168,3 +254,92 @@ func flattenPackage(remoteState *gofastly.Package, pkgType PkgType, pkgData stri
 	result = append(result, data)
 	return result
 }
+
+// packageManifestWarnings returns a message for every backend and store
+// declared in the setup section of the package manifest that has no matching
+// backend or resource_link block on the service.
+func packageManifestWarnings(m *packageManifest, backends, resourceLinks []string) []string {
+	var warnings []string
+	for _, name := range sortedKeys(m.Setup.Backends) {
+		if !slices.Contains(backends, name) {
+			warnings = append(warnings, fmt.Sprintf("the package manifest declares backend '%s' but the service has no backend with that name", name))
+		}
+	}
+	for kind, stores := range map[string]map[string]any{
+		"Config Store": m.Setup.ConfigStores,
+		"KV Store":     m.Setup.KVStores,
+		"Secret Store": m.Setup.SecretStores,
+	} {
+		for _, name := range sortedKeys(stores) {
+			if !slices.Contains(resourceLinks, name) {
+				warnings = append(warnings, fmt.Sprintf("the package manifest declares %s '%s' but the service has no resource_link with that name", kind, name))
+			}
+		}
+	}
+	sort.Strings(warnings)
+	return warnings
+}
+
+// servicePackageManifestWarnings reads the manifest of the package configured
+// on a Compute service and checks it against the service's backend and
+// resource_link blocks. Packages that are not known yet or cannot be read are
+// skipped, as Process reports problems with the package itself.
+func servicePackageManifestWarnings(get func(string) any) []string {
+	var r io.Reader
+	if v, _ := get("package.0.content").(string); v != "" {
+		data, err := base64.StdEncoding.DecodeString(v)
+		if err != nil {
+			return nil
+		}
+		r = bytes.NewReader(data)
+	} else if v, _ := get("package.0.filename").(string); v != "" {
+		// G304 (CWE-22): Potential file inclusion via variable
+		// #nosec
+		f, err := os.Open(v)
+		if err != nil {
+			log.Printf("[DEBUG] Skipping package manifest checks, failed to open package '%s': %s", v, err)
+			return nil
+		}
+		defer f.Close()
+		r = f
+	} else {
+		return nil
+	}
+
+	files, err := readPackageFiles(r)
+	if err != nil {
+		return nil
+	}
+	m, err := parsePackageManifest(files)
+	if err != nil || m == nil {
+		return nil
+	}
+
+	names := func(key string) []string {
+		var names []string
+		if s, ok := get(key).(*schema.Set); ok {
+			for _, v := range s.List() {
+				names = append(names, v.(map[string]any)["name"].(string))
+			}
+		}
+		return names
+	}
+
+	return packageManifestWarnings(m, names("backend"), names("resource_link"))
+}
+
+// packageManifestDiags returns the package manifest warnings for a Compute
+// service as diagnostics. They are only reported when the service is applied:
+// the SDK cannot return warnings from a CustomizeDiff, and attribute validators
+// cannot see the backend and resource_link blocks.
+func packageManifestDiags(d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, w := range servicePackageManifestWarnings(d.Get) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Package manifest declares resources the service does not configure",
+			Detail:   w,
+		})
+	}
+	return diags
+}
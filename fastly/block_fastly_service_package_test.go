@@ -5,10 +5,14 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"testing/fstest"
 
 	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -311,3 +315,118 @@ resource "fastly_service_compute" "foo" {
 }
 `, name, domain)
 }
+
+func TestPackageManifestWarnings(t *testing.T) {
+	m := &packageManifest{}
+	m.Setup.Backends = map[string]any{"origin": nil, "api": nil}
+	m.Setup.ConfigStores = map[string]any{"config": nil}
+	m.Setup.KVStores = map[string]any{"kv": nil}
+	m.Setup.SecretStores = map[string]any{"secrets": nil}
+
+	got := packageManifestWarnings(m, []string{"origin"}, []string{"config", "secrets"})
+	want := []string{
+		"the package manifest declares KV Store 'kv' but the service has no resource_link with that name",
+		"the package manifest declares backend 'api' but the service has no backend with that name",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected warnings (-want +got):\n%s", diff)
+	}
+}
+
+func TestPackageManifestDiags(t *testing.T) {
+	manifest := `name = "example"
+
+[setup.backends.origin]
+[setup.backends.api]
+[setup.kv_stores.kv]
+`
+	pkg, err := buildPackageArchive(fstest.MapFS{
+		"fastly.toml":   {Data: []byte(manifest)},
+		"bin/main.wasm": {Data: []byte("\x00asm")},
+	}, "example", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceServiceCompute().Schema, map[string]any{
+		"name": "example",
+		"backend": []any{
+			map[string]any{"address": "example.com", "name": "origin"},
+		},
+		"package": []any{
+			map[string]any{"content": base64.StdEncoding.EncodeToString(pkg)},
+		},
+	})
+
+	var got []string
+	for _, w := range packageManifestDiags(d) {
+		if w.Severity != diag.Warning {
+			t.Errorf("expected a warning, got severity %v: %s", w.Severity, w.Detail)
+		}
+		got = append(got, w.Detail)
+	}
+	want := []string{
+		"the package manifest declares KV Store 'kv' but the service has no resource_link with that name",
+		"the package manifest declares backend 'api' but the service has no backend with that name",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected diagnostics (-want +got):\n%s", diff)
+	}
+}
+
+func TestValidatePackage(t *testing.T) {
+	valid, err := os.ReadFile("./test_fixtures/package/valid.tar.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, filesHash, err := validatePackage(valid)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if want := "a763d3c88968ebc17691900d3c14306762296df8e47a1c2d7661cee0e0c5aa6d4c082a7c128d6e719fe333b73b46fe3ae32694716ccd2efa21f5d9f049ceec6d"; filesHash != want {
+		t.Errorf("unexpected files hash: %s", filesHash)
+	}
+
+	invalid, err := os.ReadFile("./test_fixtures/package/invalid.tar.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := validatePackage(invalid); err == nil {
+		t.Errorf("expected error for a package without bin/main.wasm")
+	}
+
+	if _, _, err := validatePackage([]byte("not a package")); err == nil {
+		t.Errorf("expected error for a package that is not a gzipped tar")
+	}
+}
+
+func TestPackageMatches(t *testing.T) {
+	for name, testcase := range map[string]struct {
+		remoteState *gofastly.Package
+		want        bool
+	}{
+		"no package": {
+			remoteState: &gofastly.Package{},
+		},
+		"hashsum matches": {
+			remoteState: &gofastly.Package{Metadata: &gofastly.PackageMetadata{HashSum: gofastly.ToPointer("sum")}},
+			want:        true,
+		},
+		"files hash matches": {
+			remoteState: &gofastly.Package{Metadata: &gofastly.PackageMetadata{FilesHash: gofastly.ToPointer("files")}},
+			want:        true,
+		},
+		"different package": {
+			remoteState: &gofastly.Package{Metadata: &gofastly.PackageMetadata{
+				FilesHash: gofastly.ToPointer("other"),
+				HashSum:   gofastly.ToPointer("other"),
+			}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if got := packageMatches(testcase.remoteState, "sum", "files"); got != testcase.want {
+				t.Errorf("want %t, got %t", testcase.want, got)
+			}
+		})
+	}
+}
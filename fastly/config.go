@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"sync"
 	"time"
 
 	gofastly "github.com/fastly/go-fastly/v9/fastly"
@@ -26,6 +27,12 @@ type Config struct {
 // APIClient is a HTTP API Client.
 type APIClient struct {
 	conn *gofastly.Client
+	// noAuth is set when the provider is configured without an API key.
+	noAuth bool
+
+	datacentersOnce sync.Once
+	datacenters     []gofastly.Datacenter
+	datacentersErr  error
 }
 
 // Client returns a FastlyClient.
@@ -80,5 +87,6 @@ func (c *Config) Client() (*APIClient, diag.Diagnostics) {
 	}
 
 	client.conn = fastlyClient
+	client.noAuth = c.NoAuth
 	return &client, nil
 }
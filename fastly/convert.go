@@ -2,6 +2,7 @@ package fastly
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
@@ -15,6 +16,15 @@ func intOrDefault(int *int) int {
 	return *int
 }
 
+// formatRFC3339Time formats a time in UTC in RFC 3339 format, or returns an
+// empty string if the time is nil.
+func formatRFC3339Time(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
 // diagToErr takes a diag.Diagnostics and finds the first Error (ignoring Warnings).
 // This is useful for some of the SDK functions which are context aware but still return Go errors, e.g. StateContext
 // and resource.RetryContext.
@@ -2,6 +2,7 @@ package fastly
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -14,3 +15,10 @@ func TestDefaultInt(t *testing.T) {
 	v := int(10)
 	assert.Equal(t, v, intOrDefault(&v))
 }
+
+func TestFormatRFC3339Time(t *testing.T) {
+	assert.Equal(t, "", formatRFC3339Time(nil))
+
+	v := time.Date(2025, 1, 10, 13, 30, 0, 0, time.FixedZone("CET", 3600))
+	assert.Equal(t, "2025-01-10T12:30:00Z", formatRFC3339Time(&v))
+}
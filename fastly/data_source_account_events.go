@@ -0,0 +1,387 @@
+package fastly
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/fastly/terraform-provider-fastly/fastly/hashcode"
+)
+
+// accountEventVersionActivate is the type of the events recorded when a
+// service version is activated.
+const accountEventVersionActivate = "version.activate"
+
+func dataSourceFastlyAccountEvents() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFastlyAccountEventsRead,
+		Schema: map[string]*schema.Schema{
+			"created_after": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only return events created at or after this date and time, in RFC 3339 format.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+			},
+			"created_before": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only return events created before this date and time, in RFC 3339 format.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+			},
+			"event_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return events of this type, e.g. `version.activate`.",
+			},
+			"events": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The events matching the filters, most recent first. If `truncated` is `true`, these are only the oldest events matching the filters.",
+				Elem: &schema.Resource{
+					Schema: accountEventSchema(),
+				},
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IDs of the events matching the filters, most recent first. If `truncated` is `true`, these are only the oldest events matching the filters.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"max_pages": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          10,
+				Description:      "The maximum number of pages of events to fetch, counted from the first page with events created at or after `created_after`. The API returns the oldest events first, so when the limit is reached only the oldest events matching the filters are returned (see `truncated`). Set to `0` to fetch all the pages. Default `10`",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			},
+			"page_size": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          100,
+				Description:      "The number of events to fetch per page. Default `100`",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 1000)),
+			},
+			"service_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return events about the service with this ID.",
+			},
+			"truncated": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether `max_pages` stopped the pagination before all the events matching the filters were fetched.",
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return events caused by the user with this ID.",
+			},
+		},
+	}
+}
+
+// accountEventSchema returns the attributes describing a single account event.
+func accountEventSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"admin": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the event was caused by a Fastly administrator.",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Date and time in ISO 8601 format.",
+		},
+		"customer_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Alphanumeric string identifying the customer.",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "A description of the event.",
+		},
+		"event_type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The type of the event, e.g. `version.activate`.",
+		},
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Alphanumeric string identifying the event.",
+		},
+		"ip": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The IP address the event originated from.",
+		},
+		"metadata": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The metadata of the event, as a JSON object.",
+		},
+		"service_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Alphanumeric string identifying the service the event is about, if any.",
+		},
+		"user_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Alphanumeric string identifying the user who caused the event.",
+		},
+	}
+}
+
+func dataSourceFastlyAccountEventsRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	log.Printf("[DEBUG] Reading account events")
+
+	// The events API has no date filters, so they are applied here. The
+	// values have been validated.
+	var after, before time.Time
+	if v := d.Get("created_after").(string); v != "" {
+		after, _ = time.Parse(time.RFC3339, v)
+	}
+	if v := d.Get("created_before").(string); v != "" {
+		before, _ = time.Parse(time.RFC3339, v)
+	}
+
+	events, truncated, err := listAccountEvents(conn, &gofastly.GetAPIEventsFilterInput{
+		EventType:  d.Get("event_type").(string),
+		MaxResults: d.Get("page_size").(int),
+		ServiceID:  d.Get("service_id").(string),
+		UserID:     d.Get("user_id").(string),
+	}, d.Get("max_pages").(int), after, before)
+	if err != nil {
+		return diag.Errorf("error fetching account events: %s", err)
+	}
+	events = filterAccountEvents(events, after, before)
+
+	ids := make([]string, 0, len(events))
+	for _, e := range events {
+		ids = append(ids, e.ID)
+	}
+	hashBase, _ := json.Marshal(ids)
+	d.SetId(strconv.Itoa(hashcode.String(string(hashBase))))
+
+	if err := d.Set("events", flattenAccountEvents(events)); err != nil {
+		return diag.Errorf("error setting events: %s", err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return diag.Errorf("error setting event IDs: %s", err)
+	}
+	if err := d.Set("truncated", truncated); err != nil {
+		return diag.Errorf("error setting truncated: %s", err)
+	}
+
+	return nil
+}
+
+// listAccountEvents fetches the events matching the input one page at a time.
+// The API returns the oldest events first, so the pages before the window
+// [after, before) do not count towards maxPages (no limit if 0), and the
+// pagination stops at the first page past the window. It reports whether
+// maxPages stopped the pagination before the end of the window.
+func listAccountEvents(conn *gofastly.Client, input *gofastly.GetAPIEventsFilterInput, maxPages int, after, before time.Time) ([]*gofastly.Event, bool, error) {
+	var result []*gofastly.Event
+	reached := false
+	counted := 0
+	for page := 1; ; page++ {
+		// PageNumber must be set, otherwise every page is fetched at once.
+		input.PageNumber = page
+		resp, err := conn.GetAPIEvents(input)
+		if err != nil {
+			return nil, false, err
+		}
+		result = append(result, resp.Events...)
+
+		pageReached, past := accountEventsPageBounds(resp.Events, after, before)
+		if past || resp.Links.Next == "" || len(resp.Events) == 0 {
+			return result, false, nil
+		}
+		reached = reached || pageReached
+		if reached {
+			counted++
+			if maxPages > 0 && counted >= maxPages {
+				return result, true, nil
+			}
+		}
+	}
+}
+
+// accountEventsPageBounds reports whether a page of events, sorted oldest
+// first, reaches the window [after, before), i.e. has events created at or
+// after `after`, and whether it goes past the window, i.e. has events created
+// at or after `before`. A zero time is not applied.
+func accountEventsPageBounds(events []*gofastly.Event, after, before time.Time) (reached, past bool) {
+	reached = after.IsZero()
+	for _, e := range events {
+		if e.CreatedAt == nil {
+			continue
+		}
+		if !e.CreatedAt.Before(after) {
+			reached = true
+		}
+		if !before.IsZero() && !e.CreatedAt.Before(before) {
+			past = true
+		}
+	}
+	return reached, past
+}
+
+// filterAccountEvents returns the events created in [after, before), most
+// recent first. A zero time is not applied.
+func filterAccountEvents(events []*gofastly.Event, after, before time.Time) []*gofastly.Event {
+	result := []*gofastly.Event{}
+	for _, e := range events {
+		if e.CreatedAt == nil {
+			if after.IsZero() && before.IsZero() {
+				result = append(result, e)
+			}
+			continue
+		}
+		if !after.IsZero() && e.CreatedAt.Before(after) {
+			continue
+		}
+		if !before.IsZero() && !e.CreatedAt.Before(before) {
+			continue
+		}
+		result = append(result, e)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return accountEventTime(result[i]).After(accountEventTime(result[j]))
+	})
+	return result
+}
+
+func accountEventTime(e *gofastly.Event) time.Time {
+	if e.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *e.CreatedAt
+}
+
+// flattenAccountEvents models data into format suitable for saving to Terraform state.
+func flattenAccountEvents(remoteState []*gofastly.Event) []map[string]any {
+	result := make([]map[string]any, 0, len(remoteState))
+	for _, e := range remoteState {
+		result = append(result, flattenAccountEvent(e))
+	}
+	return result
+}
+
+func flattenAccountEvent(e *gofastly.Event) map[string]any {
+	metadata := ""
+	if len(e.Metadata) > 0 {
+		if b, err := json.Marshal(e.Metadata); err == nil {
+			metadata = string(b)
+		}
+	}
+	return map[string]any{
+		"admin":       e.Admin,
+		"created_at":  formatRFC3339Time(e.CreatedAt),
+		"customer_id": e.CustomerID,
+		"description": e.Description,
+		"event_type":  e.EventType,
+		"id":          e.ID,
+		"ip":          e.IP,
+		"metadata":    metadata,
+		"service_id":  e.ServiceID,
+		"user_id":     e.UserID,
+	}
+}
+
+// maxActivationEventPages is the maximum number of pages of activation events
+// searched for the last external change, starting from the most recent page.
+const maxActivationEventPages = 3
+
+// getLastActivationEvent returns the most recent version activation event of a
+// service, leaving out the activations of the excluded versions, or nil if
+// there is none in the most recent pages of events.
+func getLastActivationEvent(conn *gofastly.Client, serviceID string, exclude ...int) (*gofastly.Event, error) {
+	input := &gofastly.GetAPIEventsFilterInput{
+		EventType:  accountEventVersionActivate,
+		MaxResults: 100,
+		PageNumber: 1,
+		ServiceID:  serviceID,
+	}
+	first, err := conn.GetAPIEvents(input)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching activation events for service (%s): %w", serviceID, err)
+	}
+
+	// The API returns the oldest events first, so the search starts from
+	// the last page.
+	page := max(accountEventsPageNumber(first.Links.Last), 1)
+	for i := 0; i < maxActivationEventPages && page >= 1; i, page = i+1, page-1 {
+		events := first.Events
+		if page > 1 {
+			input.PageNumber = page
+			resp, err := conn.GetAPIEvents(input)
+			if err != nil {
+				return nil, fmt.Errorf("error fetching activation events for service (%s): %w", serviceID, err)
+			}
+			events = resp.Events
+		}
+		if e := latestActivationEvent(events, exclude); e != nil {
+			return e, nil
+		}
+	}
+	return nil, nil
+}
+
+// accountEventsPageNumber returns the page number of a pagination link, or 0
+// if it has none.
+func accountEventsPageNumber(link string) int {
+	u, err := url.Parse(link)
+	if err != nil {
+		return 0
+	}
+	page, _ := strconv.Atoi(u.Query().Get("page[number]"))
+	return page
+}
+
+// latestActivationEvent returns the most recent of the activation events,
+// leaving out the activations of the excluded versions.
+func latestActivationEvent(events []*gofastly.Event, exclude []int) *gofastly.Event {
+	for _, e := range filterAccountEvents(events, time.Time{}, time.Time{}) {
+		if v, ok := accountEventVersion(e); ok && slices.Contains(exclude, v) {
+			continue
+		}
+		return e
+	}
+	return nil
+}
+
+// accountEventVersion returns the service version recorded in the metadata of
+// an event.
+func accountEventVersion(e *gofastly.Event) (int, bool) {
+	switch v := e.Metadata["version"].(type) {
+	case float64:
+		return int(v), true
+	case int:
+		return v, true
+	case string:
+		i, err := strconv.Atoi(v)
+		return i, err == nil
+	}
+	return 0, false
+}
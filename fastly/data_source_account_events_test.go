@@ -0,0 +1,174 @@
+package fastly
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestFilterAccountEvents(t *testing.T) {
+	at := func(day int) *time.Time {
+		v := time.Date(2024, 5, day, 0, 0, 0, 0, time.UTC)
+		return &v
+	}
+	events := []*gofastly.Event{
+		{ID: "a", CreatedAt: at(1)},
+		{ID: "c", CreatedAt: at(3)},
+		{ID: "b", CreatedAt: at(2)},
+		{ID: "n"},
+	}
+
+	for _, tc := range []struct {
+		name   string
+		after  time.Time
+		before time.Time
+		want   []string
+	}{
+		{name: "no filters", want: []string{"c", "b", "a", "n"}},
+		{name: "after is inclusive", after: *at(2), want: []string{"c", "b"}},
+		{name: "before is exclusive", before: *at(2), want: []string{"a"}},
+		{name: "range", after: *at(2), before: *at(3), want: []string{"b"}},
+		{name: "empty range", after: *at(4), want: []string{}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := []string{}
+			for _, e := range filterAccountEvents(events, tc.after, tc.before) {
+				got = append(got, e.ID)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAccountEventsPageBounds(t *testing.T) {
+	at := func(day int) *time.Time {
+		v := time.Date(2024, 5, day, 0, 0, 0, 0, time.UTC)
+		return &v
+	}
+	page := []*gofastly.Event{{CreatedAt: at(2)}, {CreatedAt: at(3)}, {}}
+
+	for _, tc := range []struct {
+		name        string
+		after       time.Time
+		before      time.Time
+		wantReached bool
+		wantPast    bool
+	}{
+		{name: "no filters", wantReached: true},
+		{name: "before the window", after: *at(4), wantReached: false},
+		{name: "reaches the window", after: *at(3), wantReached: true},
+		{name: "past the window", before: *at(3), wantReached: true, wantPast: true},
+		{name: "within the window", after: *at(1), before: *at(4), wantReached: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			reached, past := accountEventsPageBounds(page, tc.after, tc.before)
+			if reached != tc.wantReached || past != tc.wantPast {
+				t.Errorf("got (%t, %t), want (%t, %t)", reached, past, tc.wantReached, tc.wantPast)
+			}
+		})
+	}
+}
+
+func TestAccountEventsPageNumber(t *testing.T) {
+	for link, want := range map[string]int{
+		"": 0,
+		"https://api.fastly.com/events?page%5Bnumber%5D=7":             7,
+		"https://api.fastly.com/events?page[number]=12&page[size]=100": 12,
+		"https://api.fastly.com/events?page[size]=100":                 0,
+	} {
+		if got := accountEventsPageNumber(link); got != want {
+			t.Errorf("accountEventsPageNumber(%q) = %d, want %d", link, got, want)
+		}
+	}
+}
+
+func TestLatestActivationEvent(t *testing.T) {
+	at := func(day int) *time.Time {
+		v := time.Date(2024, 5, day, 0, 0, 0, 0, time.UTC)
+		return &v
+	}
+	events := []*gofastly.Event{
+		{ID: "a", CreatedAt: at(1), Metadata: map[string]any{"version": float64(2)}},
+		{ID: "b", CreatedAt: at(2), Metadata: map[string]any{"version": "3"}},
+		{ID: "c", CreatedAt: at(3), Metadata: map[string]any{"version": 4}},
+	}
+
+	for _, tc := range []struct {
+		name    string
+		exclude []int
+		want    string
+	}{
+		{name: "no exclusions", want: "c"},
+		{name: "int version", exclude: []int{4}, want: "b"},
+		{name: "string version", exclude: []int{4, 3}, want: "a"},
+		{name: "all excluded", exclude: []int{2, 3, 4}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := ""
+			if e := latestActivationEvent(events, tc.exclude); e != nil {
+				got = e.ID
+			}
+			if got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFlattenAccountEvent(t *testing.T) {
+	created := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+	got := flattenAccountEvent(&gofastly.Event{
+		CreatedAt:   &created,
+		CustomerID:  "customer",
+		Description: "Version 3 was activated",
+		EventType:   accountEventVersionActivate,
+		ID:          "event",
+		IP:          "192.0.2.1",
+		Metadata:    map[string]any{"version": 3},
+		ServiceID:   "service",
+		UserID:      "user",
+	})
+	want := map[string]any{
+		"admin":       false,
+		"created_at":  "2024-05-01T12:30:00Z",
+		"customer_id": "customer",
+		"description": "Version 3 was activated",
+		"event_type":  "version.activate",
+		"id":          "event",
+		"ip":          "192.0.2.1",
+		"metadata":    `{"version":3}`,
+		"service_id":  "service",
+		"user_id":     "user",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestAccFastlyDataSourceAccountEvents_Config(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "fastly_account_events" "example" {
+  page_size     = 20
+  max_pages     = 1
+  created_after = "2020-01-01T00:00:00Z"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.fastly_account_events.example", "ids.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,142 @@
+package fastly
+
+import (
+	"context"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceFastlyAlertDefinitions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFastlyAlertDefinitionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"evaluation_strategy_types": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The evaluation strategies an alert can use as `evaluation_strategy.type`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "How the strategy evaluates the metric.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the strategy.",
+						},
+					},
+				},
+			},
+			"periods": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The periods an alert can use as `evaluation_strategy.period`.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"source": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only return the definition of this source. One of: `" + strings.Join(alertDefinitions.sourceNames(), "`, `") + "`.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(alertDefinitions.sourceNames(), false)),
+			},
+			"sources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The sources an alert can use, with the metrics and dimensions available for each.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "What the source measures.",
+						},
+						"dimensions": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The `dimensions` an alert on the source can filter on.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"metrics": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The metrics an alert on the source can use.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the source, used as `source`.",
+						},
+						"requires_service_id": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether an alert on the source must set `service_id`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFastlyAlertDefinitionsRead(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+	log.Printf("[DEBUG] Reading alert definitions")
+
+	source := d.Get("source").(string)
+
+	d.SetId("alert-definitions")
+	if source != "" {
+		d.SetId("alert-definitions/" + source)
+	}
+
+	if err := d.Set("evaluation_strategy_types", flattenAlertEvaluationStrategyTypes(alertDefinitions.EvaluationStrategyTypes)); err != nil {
+		return diag.Errorf("error setting evaluation strategy types: %s", err)
+	}
+	if err := d.Set("periods", alertDefinitions.Periods); err != nil {
+		return diag.Errorf("error setting periods: %s", err)
+	}
+	if err := d.Set("sources", flattenAlertSources(alertDefinitions.Sources, source)); err != nil {
+		return diag.Errorf("error setting sources: %s", err)
+	}
+
+	return nil
+}
+
+// flattenAlertEvaluationStrategyTypes models data into format suitable for saving to Terraform state.
+func flattenAlertEvaluationStrategyTypes(types []alertCatalogueItem) []map[string]any {
+	result := make([]map[string]any, 0, len(types))
+	for _, t := range types {
+		result = append(result, map[string]any{
+			"description": t.Description,
+			"name":        t.Name,
+		})
+	}
+	return result
+}
+
+// flattenAlertSources models data into format suitable for saving to Terraform
+// state, keeping only the named source if name is set.
+func flattenAlertSources(sources []alertCatalogueSource, name string) []map[string]any {
+	result := make([]map[string]any, 0, len(sources))
+	for _, s := range sources {
+		if name != "" && s.Name != name {
+			continue
+		}
+		result = append(result, map[string]any{
+			"description":         s.Description,
+			"dimensions":          slices.Clone(s.Dimensions),
+			"metrics":             slices.Clone(s.Metrics),
+			"name":                s.Name,
+			"requires_service_id": s.RequiresServiceID,
+		})
+	}
+	return result
+}
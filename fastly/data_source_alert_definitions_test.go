@@ -0,0 +1,35 @@
+package fastly
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccFastlyDataSourceAlertDefinitions_Config(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "fastly_alert_definitions" "all" {}
+
+data "fastly_alert_definitions" "origins" {
+  source = "origins"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fastly_alert_definitions.all", "sources.#", "3"),
+					resource.TestCheckResourceAttr("data.fastly_alert_definitions.origins", "sources.#", "1"),
+					resource.TestCheckResourceAttr("data.fastly_alert_definitions.origins", "sources.0.name", "origins"),
+					resource.TestCheckResourceAttr("data.fastly_alert_definitions.origins", "sources.0.dimensions.0", "origins"),
+					resource.TestCheckTypeSetElemAttr("data.fastly_alert_definitions.origins", "sources.0.metrics.*", "status_5xx"),
+					resource.TestCheckTypeSetElemAttr("data.fastly_alert_definitions.all", "periods.*", "5m"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,120 @@
+package fastly
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strconv"
+	"time"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/fastly/terraform-provider-fastly/fastly/hashcode"
+)
+
+func dataSourceFastlyAutomationTokens() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFastlyAutomationTokensRead,
+		Schema: tokenFilterSchema(map[string]*schema.Schema{
+			"role": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only return automation tokens with this role. Can be `billing`, `engineer` or `user`.",
+				ValidateDiagFunc: validateAutomationTokenRole(),
+			},
+			"tokens": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The automation tokens matching the filters.",
+				Elem: &schema.Resource{
+					Schema: tokenDetailsSchema(map[string]*schema.Schema{
+						"customer_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Alphanumeric string identifying the customer.",
+						},
+						"role": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The role of the token.",
+						},
+						"tls_access": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the token can manage TLS configuration.",
+						},
+					}),
+				},
+			},
+		}),
+	}
+}
+
+func dataSourceFastlyAutomationTokensRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	log.Printf("[DEBUG] Reading automation tokens")
+
+	var remoteState []*gofastly.AutomationToken
+	p := conn.GetAutomationTokens(&gofastly.GetAutomationTokensInput{})
+	for p.HasNext() {
+		pages, err := p.GetNext()
+		if err != nil {
+			return diag.Errorf("error fetching automation tokens (remaining pages: %d): %s", p.Remaining(), err)
+		}
+		for _, page := range pages {
+			remoteState = append(remoteState, page.Data...)
+		}
+	}
+
+	filters := expandTokenFilters(d)
+	role := d.Get("role").(string)
+	now := time.Now()
+
+	var (
+		ids    []string
+		tokens []map[string]any
+	)
+	for _, t := range remoteState {
+		attrs := tokenAttributes{
+			CreatedAt:  t.CreatedAt,
+			ExpiresAt:  t.ExpiresAt,
+			LastUsedAt: t.LastUsedAt,
+			Name:       t.Name,
+			Scope:      t.Scope,
+			Services:   t.Services,
+			UserID:     t.UserID,
+		}
+		if !filters.matches(attrs, now) {
+			continue
+		}
+		if role != "" && (t.Role == nil || string(*t.Role) != role) {
+			continue
+		}
+		token := flattenTokenDetails(attrs, now)
+		token["customer_id"] = gofastly.ToValue(t.CustomerID)
+		token["id"] = gofastly.ToValue(t.TokenID)
+		token["ip"] = gofastly.ToValue(t.IP)
+		token["tls_access"] = gofastly.ToValue(t.TLSAccess)
+		if t.Role != nil {
+			token["role"] = string(*t.Role)
+		}
+		ids = append(ids, gofastly.ToValue(t.TokenID))
+		tokens = append(tokens, token)
+	}
+
+	hashBase, _ := json.Marshal(ids)
+	hashString := strconv.Itoa(hashcode.String(string(hashBase)))
+	d.SetId(hashString)
+
+	if err := d.Set("ids", ids); err != nil {
+		return diag.Errorf("error setting automation token IDs: %s", err)
+	}
+	if err := d.Set("tokens", tokens); err != nil {
+		return diag.Errorf("error setting automation tokens: %s", err)
+	}
+
+	return nil
+}
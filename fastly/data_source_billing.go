@@ -0,0 +1,222 @@
+package fastly
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceFastlyBilling() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFastlyBillingRead,
+		Schema: map[string]*schema.Schema{
+			"bandwidth": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The bandwidth billed for the month, in GB.",
+			},
+			"bandwidth_cost": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The cost of the bandwidth.",
+			},
+			"cost": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The total cost of the month.",
+			},
+			"cost_before_discount": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The total cost of the month before the discount.",
+			},
+			"discount": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The discount applied to the month.",
+			},
+			"end_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The end of the billing period, in RFC 3339 format.",
+			},
+			"extras": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The extras billed for the month, such as TLS add-ons.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the extra.",
+						},
+						"recurring": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The recurring cost of the extra.",
+						},
+						"setup": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The setup cost of the extra.",
+						},
+					},
+				},
+			},
+			"extras_cost": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The total cost of the extras.",
+			},
+			"incurred_cost": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The cost incurred so far in the month.",
+			},
+			"invoice_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Alphanumeric string identifying the invoice.",
+			},
+			"month": {
+				Type:             schema.TypeInt,
+				Required:         true,
+				Description:      "The month of the billing period, from `1` to `12`.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 12)),
+			},
+			"overage": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The cost above the plan minimum.",
+			},
+			"plan_code": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The code of the account's plan.",
+			},
+			"plan_minimum": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The minimum monthly cost of the account's plan.",
+			},
+			"plan_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the account's plan.",
+			},
+			"requests": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of requests billed for the month.",
+			},
+			"requests_cost": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The cost of the requests.",
+			},
+			"sent_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the invoice was sent, in RFC 3339 format.",
+			},
+			"start_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The start of the billing period, in RFC 3339 format.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the invoice, e.g. `Pending` or `Outstanding`.",
+			},
+			"terms": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The payment terms of the invoice.",
+			},
+			"year": {
+				Type:             schema.TypeInt,
+				Required:         true,
+				Description:      "The year of the billing period, e.g. `2024`.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(2000, 9999)),
+			},
+		},
+	}
+}
+
+func dataSourceFastlyBillingRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	year := d.Get("year").(int)
+	month := d.Get("month").(int)
+
+	log.Printf("[DEBUG] Reading billing for %04d-%02d", year, month)
+
+	billing, err := conn.GetBilling(&gofastly.GetBillingInput{
+		Month: uint8(month),
+		Year:  uint16(year),
+	})
+	if err != nil {
+		return diag.Errorf("error fetching billing for %04d-%02d: %s", year, month, err)
+	}
+
+	d.SetId(fmt.Sprintf("%04d-%02d", year, month))
+
+	values := flattenBilling(billing)
+	for _, key := range sortedKeys(values) {
+		if err := d.Set(key, values[key]); err != nil {
+			return diag.Errorf("error setting %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
+
+// flattenBilling models data into format suitable for saving to Terraform state.
+func flattenBilling(b *gofastly.Billing) map[string]any {
+	result := map[string]any{
+		"end_time":   formatRFC3339Time(b.EndTime),
+		"invoice_id": gofastly.ToValue(b.InvoiceID),
+		"start_time": formatRFC3339Time(b.StartTime),
+	}
+
+	if s := b.Status; s != nil {
+		result["sent_at"] = formatRFC3339Time(s.SentAt)
+		result["status"] = gofastly.ToValue(s.Status)
+	}
+
+	if t := b.Total; t != nil {
+		extras := make([]map[string]any, 0, len(t.Extras))
+		for _, e := range t.Extras {
+			extras = append(extras, map[string]any{
+				"name":      gofastly.ToValue(e.Name),
+				"recurring": gofastly.ToValue(e.Recurring),
+				"setup":     gofastly.ToValue(e.Setup),
+			})
+		}
+
+		result["bandwidth"] = gofastly.ToValue(t.Bandwidth)
+		result["bandwidth_cost"] = gofastly.ToValue(t.BandwidthCost)
+		result["cost"] = gofastly.ToValue(t.Cost)
+		result["cost_before_discount"] = gofastly.ToValue(t.CostBeforeDiscount)
+		result["discount"] = gofastly.ToValue(t.Discount)
+		result["extras"] = extras
+		result["extras_cost"] = gofastly.ToValue(t.ExtrasCost)
+		result["incurred_cost"] = gofastly.ToValue(t.IncurredCost)
+		result["overage"] = gofastly.ToValue(t.Overage)
+		result["plan_code"] = gofastly.ToValue(t.PlanCode)
+		result["plan_minimum"] = gofastly.ToValue(t.PlanMinimum)
+		result["plan_name"] = gofastly.ToValue(t.PlanName)
+		result["requests"] = int(gofastly.ToValue(t.Requests))
+		result["requests_cost"] = gofastly.ToValue(t.RequestsCost)
+		result["terms"] = gofastly.ToValue(t.Terms)
+	}
+
+	return result
+}
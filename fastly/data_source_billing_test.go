@@ -0,0 +1,80 @@
+package fastly
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestFlattenBilling(t *testing.T) {
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 5, 31, 23, 59, 59, 0, time.UTC)
+
+	got := flattenBilling(&gofastly.Billing{
+		EndTime:   &end,
+		InvoiceID: gofastly.ToPointer("inv"),
+		StartTime: &start,
+		Status: &gofastly.BillingStatus{
+			Status: gofastly.ToPointer("Pending"),
+		},
+		Total: &gofastly.BillingTotal{
+			Bandwidth: gofastly.ToPointer(12.5),
+			Cost:      gofastly.ToPointer(99.0),
+			Extras: []*gofastly.BillingExtra{
+				{Name: gofastly.ToPointer("tls"), Recurring: gofastly.ToPointer(10.0)},
+			},
+			PlanName: gofastly.ToPointer("Starter"),
+			Requests: gofastly.ToPointer(uint64(1000)),
+		},
+	})
+
+	for key, want := range map[string]any{
+		"bandwidth":  12.5,
+		"cost":       99.0,
+		"discount":   0.0,
+		"end_time":   "2024-05-31T23:59:59Z",
+		"invoice_id": "inv",
+		"plan_name":  "Starter",
+		"requests":   1000,
+		"sent_at":    "",
+		"start_time": "2024-05-01T00:00:00Z",
+		"status":     "Pending",
+		"extras": []map[string]any{
+			{"name": "tls", "recurring": 10.0, "setup": 0.0},
+		},
+	} {
+		if !reflect.DeepEqual(got[key], want) {
+			t.Errorf("%s: got %#v, want %#v", key, got[key], want)
+		}
+	}
+}
+
+func TestAccFastlyDataSourceBilling_Config(t *testing.T) {
+	lastMonth := time.Now().UTC().AddDate(0, -1, 0)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "fastly_billing" "example" {
+  year  = %d
+  month = %d
+}
+`, lastMonth.Year(), lastMonth.Month()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fastly_billing.example", "id", lastMonth.Format("2006-01")),
+					resource.TestCheckResourceAttrSet("data.fastly_billing.example", "cost"),
+					resource.TestCheckResourceAttrSet("data.fastly_billing.example", "start_time"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,86 @@
+package fastly
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFastlyConfigStoreEntries() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFastlyConfigStoreEntriesRead,
+		Schema: map[string]*schema.Schema{
+			"entries": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "A map of the matching entries in the Config Store (key/value).",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"key_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return entries whose key starts with this prefix. If not set, all entries are returned.",
+			},
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The sorted keys of the matching entries.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"store_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "An alphanumeric string identifying the Config Store.",
+			},
+		},
+	}
+}
+
+func dataSourceFastlyConfigStoreEntriesRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	storeID := d.Get("store_id").(string)
+	prefix := d.Get("key_prefix").(string)
+
+	log.Printf("[DEBUG] Reading Config Store (%s) entries with prefix (%s)", storeID, prefix)
+
+	remoteState, err := conn.ListConfigStoreItems(&gofastly.ListConfigStoreItemsInput{
+		StoreID: storeID,
+	})
+	if err != nil {
+		return diag.Errorf("error fetching Config Store (%s) entries: %s", storeID, err)
+	}
+
+	entries := filterKeyPrefix(flattenConfigStoreEntries(remoteState), prefix)
+
+	d.SetId(fmt.Sprintf("%s/%s", storeID, prefix))
+
+	if err := d.Set("entries", entries); err != nil {
+		return diag.Errorf("error setting entries: %s", err)
+	}
+	if err := d.Set("keys", sortedKeys(entries)); err != nil {
+		return diag.Errorf("error setting keys: %s", err)
+	}
+
+	return nil
+}
+
+// filterKeyPrefix returns the entries whose key starts with prefix.
+func filterKeyPrefix[V any](entries map[string]V, prefix string) map[string]V {
+	result := make(map[string]V)
+	for k, v := range entries {
+		if strings.HasPrefix(k, prefix) {
+			result[k] = v
+		}
+	}
+	return result
+}
@@ -0,0 +1,78 @@
+package fastly
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestFilterKeyPrefix(t *testing.T) {
+	entries := map[string]string{
+		"route/a": "1",
+		"route/b": "2",
+		"other":   "3",
+	}
+
+	got := filterKeyPrefix(entries, "route/")
+	want := map[string]string{"route/a": "1", "route/b": "2"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want %#v, got %#v", want, got)
+	}
+
+	if got := filterKeyPrefix(entries, ""); !reflect.DeepEqual(got, entries) {
+		t.Errorf("expected an empty prefix to return all entries, got %#v", got)
+	}
+}
+
+func TestAccFastlyDataSourceConfigStoreEntries_Config(t *testing.T) {
+	storeName := fmt.Sprintf("store_%s", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFastlyDataSourceConfigStoreEntriesConfig(storeName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fastly_configstore_entry.example", "value", "value1"),
+					resource.TestCheckResourceAttr("data.fastly_configstore_entries.example", "entries.%", "2"),
+					resource.TestCheckResourceAttr("data.fastly_configstore_entries.example", "entries.route/b", "value3"),
+					resource.TestCheckResourceAttr("data.fastly_configstore_entries.example", "keys.0", "route/a"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFastlyDataSourceConfigStoreEntriesConfig(storeName string) string {
+	return fmt.Sprintf(`
+resource "fastly_configstore" "example" {
+  name          = "%s"
+  force_destroy = true
+}
+
+resource "fastly_configstore_entries" "example" {
+  store_id = fastly_configstore.example.id
+  entries = {
+    "key1" : "value1"
+    "route/a" : "value2"
+    "route/b" : "value3"
+  }
+}
+
+data "fastly_configstore_entry" "example" {
+  store_id = fastly_configstore_entries.example.store_id
+  key      = "key1"
+}
+
+data "fastly_configstore_entries" "example" {
+  store_id   = fastly_configstore_entries.example.store_id
+  key_prefix = "route/"
+}
+`, storeName)
+}
@@ -0,0 +1,59 @@
+package fastly
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFastlyConfigStoreEntry() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFastlyConfigStoreEntryRead,
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The key of the entry to read.",
+			},
+			"store_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "An alphanumeric string identifying the Config Store.",
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The value of the entry.",
+			},
+		},
+	}
+}
+
+func dataSourceFastlyConfigStoreEntryRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	storeID := d.Get("store_id").(string)
+	key := d.Get("key").(string)
+
+	log.Printf("[DEBUG] Reading Config Store (%s) entry (%s)", storeID, key)
+
+	item, err := conn.GetConfigStoreItem(&gofastly.GetConfigStoreItemInput{
+		StoreID: storeID,
+		Key:     key,
+	})
+	if err != nil {
+		return diag.Errorf("error fetching Config Store (%s) entry (%s): %s", storeID, key, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", storeID, key))
+
+	if err := d.Set("value", item.Value); err != nil {
+		return diag.Errorf("error setting value: %s", err)
+	}
+
+	return nil
+}
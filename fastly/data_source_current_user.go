@@ -0,0 +1,40 @@
+package fastly
+
+import (
+	"context"
+	"log"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFastlyCurrentUser() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFastlyCurrentUserRead,
+		Schema:      userDetailsSchema(),
+	}
+}
+
+func dataSourceFastlyCurrentUserRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	log.Printf("[DEBUG] Reading current user")
+
+	user, err := conn.GetCurrentUser()
+	if err != nil {
+		return diag.Errorf("error fetching current user: %s", err)
+	}
+
+	d.SetId(gofastly.ToValue(user.UserID))
+
+	values := flattenUser(user)
+	delete(values, "id")
+	for _, key := range sortedKeys(values) {
+		if err := d.Set(key, values[key]); err != nil {
+			return diag.Errorf("error setting %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
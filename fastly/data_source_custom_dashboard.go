@@ -0,0 +1,101 @@
+package fastly
+
+import (
+	"context"
+	"log"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFastlyCustomDashboard() *schema.Resource {
+	items := computedSchema(resourceFastlyCustomDashboard().Schema["dashboard_item"])
+	items.Description = "A list of dashboard items."
+
+	return &schema.Resource{
+		ReadContext: dataSourceFastlyCustomDashboardRead,
+
+		Schema: map[string]*schema.Schema{
+			"dashboard_item": items,
+			"dashboard_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The dashboard items as a JSON document, suitable for the `dashboard_json` attribute of the `fastly_custom_dashboard` resource.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A short description of the dashboard.",
+			},
+			"id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Dashboard identifier (UUID).",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A human-readable name.",
+			},
+		},
+	}
+}
+
+func dataSourceFastlyCustomDashboardRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	id := d.Get("id").(string)
+	log.Printf("[DEBUG] Reading Custom Dashboard (%s)", id)
+
+	dash, err := conn.GetObservabilityCustomDashboard(&gofastly.GetObservabilityCustomDashboardInput{
+		ID: gofastly.ToPointer(id),
+	})
+	if err != nil {
+		return diag.Errorf("error fetching custom dashboard (%s): %s", id, err)
+	}
+
+	dashboardJSON, err := normaliseDashboardItems(dash.Items)
+	if err != nil {
+		return diag.Errorf("error encoding custom dashboard (%s): %s", id, err)
+	}
+
+	d.SetId(dash.ID)
+
+	if err := d.Set("name", dash.Name); err != nil {
+		return diag.Errorf("error setting name: %s", err)
+	}
+	if err := d.Set("description", dash.Description); err != nil {
+		return diag.Errorf("error setting description: %s", err)
+	}
+	if err := d.Set("dashboard_json", dashboardJSON); err != nil {
+		return diag.Errorf("error setting dashboard JSON: %s", err)
+	}
+	if err := d.Set("dashboard_item", flattenDashboardItems(dash.Items)); err != nil {
+		return diag.Errorf("error setting dashboard items: %s", err)
+	}
+
+	return nil
+}
+
+// computedSchema returns a copy of a resource attribute, and of its nested
+// attributes, for use as a computed data source attribute.
+func computedSchema(s *schema.Schema) *schema.Schema {
+	c := &schema.Schema{
+		Type:        s.Type,
+		Computed:    true,
+		Description: s.Description,
+		Sensitive:   s.Sensitive,
+	}
+	switch elem := s.Elem.(type) {
+	case *schema.Resource:
+		nested := make(map[string]*schema.Schema, len(elem.Schema))
+		for k, v := range elem.Schema {
+			nested[k] = computedSchema(v)
+		}
+		c.Elem = &schema.Resource{Schema: nested}
+	case *schema.Schema:
+		c.Elem = &schema.Schema{Type: elem.Type}
+	}
+	return c
+}
@@ -4,11 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"log"
+	"slices"
+	"sort"
 	"strconv"
+	"strings"
 
 	gofastly "github.com/fastly/go-fastly/v9/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/fastly/terraform-provider-fastly/fastly/hashcode"
 )
@@ -18,10 +22,37 @@ func dataSourceFastlyDatacenters() *schema.Resource {
 		ReadContext: dataSourceFastlyDatacentersRead,
 
 		Schema: map[string]*schema.Schema{
+			"group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the POPs in this general region of the world, e.g. `Europe`. The comparison is case-insensitive.",
+			},
+			"near": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Sort the POPs by distance to this location, nearest first, and set their `distance`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"latitude": {
+							Type:             schema.TypeFloat,
+							Required:         true,
+							Description:      "The latitude of the location, in degrees.",
+							ValidateDiagFunc: validation.ToDiagFunc(validation.FloatBetween(-90, 90)),
+						},
+						"longitude": {
+							Type:             schema.TypeFloat,
+							Required:         true,
+							Description:      "The longitude of the location, in degrees.",
+							ValidateDiagFunc: validation.ToDiagFunc(validation.FloatBetween(-180, 180)),
+						},
+					},
+				},
+			},
 			"pops": {
 				Type:        schema.TypeList,
 				Computed:    true,
-				Description: "A list of all Fastly POPs.",
+				Description: "A list of the Fastly POPs matching the filters.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"code": {
@@ -29,11 +60,26 @@ func dataSourceFastlyDatacenters() *schema.Resource {
 							Computed:    true,
 							Description: "A code representing the POP location.",
 						},
+						"distance": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The distance between the POP and the `near` location, in kilometers. Only set if `near` is set.",
+						},
 						"group": {
 							Type:        schema.TypeString,
 							Computed:    true,
 							Description: "A code representing the general region of the world in which the POP location resides.",
 						},
+						"latitude": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The latitude of the POP, in degrees.",
+						},
+						"longitude": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The longitude of the POP, in degrees.",
+						},
 						"name": {
 							Type:        schema.TypeString,
 							Computed:    true,
@@ -47,6 +93,12 @@ func dataSourceFastlyDatacenters() *schema.Resource {
 					},
 				},
 			},
+			"shield_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to only return the POPs available for shielding. Default `false`",
+			},
 		},
 	}
 }
@@ -61,19 +113,42 @@ func dataSourceFastlyDatacentersRead(_ context.Context, d *schema.ResourceData,
 		return diag.Errorf("error fetching datacenters: %s", err)
 	}
 
-	hashBase, _ := json.Marshal(remoteState)
+	remoteState = filterDatacenters(remoteState, d.Get("group").(string), d.Get("shield_only").(bool))
+
+	var near *gofastly.Coordinates
+	if v := d.Get("near").([]any); len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]any)
+		near = &gofastly.Coordinates{
+			Latitude:   gofastly.ToPointer(m["latitude"].(float64)),
+			Longtitude: gofastly.ToPointer(m["longitude"].(float64)),
+		}
+	}
+
+	hashBase, _ := json.Marshal([]any{remoteState, near})
 	hashString := strconv.Itoa(hashcode.String(string(hashBase)))
 	d.SetId(hashString)
 
-	if err := d.Set("pops", flattenDatacenters(remoteState)); err != nil {
+	if err := d.Set("pops", flattenDatacenters(remoteState, near)); err != nil {
 		return diag.Errorf("error setting datacenters: %s", err)
 	}
 
 	return nil
 }
 
-// flattenDatacenters models data into format suitable for saving to Terraform state.
-func flattenDatacenters(remoteState []gofastly.Datacenter) []map[string]any {
+// filterDatacenters returns the datacenters in group, if set, and available for
+// shielding if shieldOnly is true.
+func filterDatacenters(datacenters []gofastly.Datacenter, group string, shieldOnly bool) []gofastly.Datacenter {
+	return slices.DeleteFunc(datacenters, func(dc gofastly.Datacenter) bool {
+		if group != "" && !strings.EqualFold(gofastly.ToValue(dc.Group), group) {
+			return true
+		}
+		return shieldOnly && gofastly.ToValue(dc.Shield) == ""
+	})
+}
+
+// flattenDatacenters models data into format suitable for saving to Terraform
+// state. If near is set, the POPs are sorted by distance to it, nearest first.
+func flattenDatacenters(remoteState []gofastly.Datacenter, near *gofastly.Coordinates) []map[string]any {
 	result := make([]map[string]any, len(remoteState))
 	if len(remoteState) == 0 {
 		return result
@@ -94,6 +169,13 @@ func flattenDatacenters(remoteState []gofastly.Datacenter) []map[string]any {
 		if resource.Shield != nil {
 			data["shield"] = *resource.Shield
 		}
+		if c := resource.Coordinates; c != nil && c.Latitude != nil && c.Longtitude != nil {
+			data["latitude"] = *c.Latitude
+			data["longitude"] = *c.Longtitude
+			if near != nil {
+				data["distance"] = greatCircleDistance(*near.Latitude, *near.Longtitude, *c.Latitude, *c.Longtitude)
+			}
+		}
 
 		// Prune any empty values that come from the default string value in structs.
 		for k, v := range data {
@@ -104,5 +186,17 @@ func flattenDatacenters(remoteState []gofastly.Datacenter) []map[string]any {
 		result[i] = data
 	}
 
+	if near != nil {
+		// POPs without coordinates are sorted last.
+		sort.SliceStable(result, func(i, j int) bool {
+			di, iok := result[i]["distance"].(float64)
+			dj, jok := result[j]["distance"].(float64)
+			if iok != jok {
+				return iok
+			}
+			return di < dj
+		})
+	}
+
 	return result
 }
@@ -2,13 +2,94 @@ package fastly
 
 import (
 	"fmt"
+	"reflect"
 	"strconv"
 	"testing"
 
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func TestFilterDatacenters(t *testing.T) {
+	datacenter := func(code, group, shield string) gofastly.Datacenter {
+		dc := gofastly.Datacenter{Code: gofastly.ToPointer(code), Group: gofastly.ToPointer(group)}
+		if shield != "" {
+			dc.Shield = gofastly.ToPointer(shield)
+		}
+		return dc
+	}
+
+	for _, tc := range []struct {
+		name       string
+		group      string
+		shieldOnly bool
+		want       []string
+	}{
+		{name: "all", want: []string{"AMS", "CDG", "IAD"}},
+		{name: "group", group: "europe", want: []string{"AMS", "CDG"}},
+		{name: "shield only", shieldOnly: true, want: []string{"AMS", "IAD"}},
+		{name: "both", group: "Europe", shieldOnly: true, want: []string{"AMS"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			datacenters := []gofastly.Datacenter{
+				datacenter("AMS", "Europe", "amsterdam-nl"),
+				datacenter("CDG", "Europe", ""),
+				datacenter("IAD", "North America", "iad-va-us"),
+			}
+			got := []string{}
+			for _, dc := range filterDatacenters(datacenters, tc.group, tc.shieldOnly) {
+				got = append(got, *dc.Code)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFlattenDatacentersNear(t *testing.T) {
+	datacenter := func(code string, latitude, longitude float64) gofastly.Datacenter {
+		return gofastly.Datacenter{
+			Code: gofastly.ToPointer(code),
+			Coordinates: &gofastly.Coordinates{
+				Latitude:   gofastly.ToPointer(latitude),
+				Longtitude: gofastly.ToPointer(longitude),
+			},
+		}
+	}
+	datacenters := []gofastly.Datacenter{
+		{Code: gofastly.ToPointer("XXX")},
+		datacenter("IAD", 38.953116, -77.456539),
+		datacenter("AMS", 52.308613, 4.763889),
+		datacenter("FRA", 50.037933, 8.562152),
+	}
+
+	// Near Brussels.
+	got := flattenDatacenters(datacenters, &gofastly.Coordinates{
+		Latitude:   gofastly.ToPointer(50.85),
+		Longtitude: gofastly.ToPointer(4.35),
+	})
+
+	codes := []string{}
+	for _, pop := range got {
+		codes = append(codes, pop["code"].(string))
+	}
+	if want := []string{"AMS", "FRA", "IAD", "XXX"}; !reflect.DeepEqual(codes, want) {
+		t.Errorf("got %v, want %v", codes, want)
+	}
+	if _, ok := got[3]["distance"]; ok {
+		t.Error("expected no distance for a POP without coordinates")
+	}
+	if got[1]["latitude"] != 50.037933 || got[1]["longitude"] != 8.562152 {
+		t.Errorf("unexpected coordinates %v, %v", got[1]["latitude"], got[1]["longitude"])
+	}
+
+	if got := flattenDatacenters(datacenters[1:2], nil); got[0]["distance"] != nil {
+		t.Error("expected no distance without a location")
+	}
+}
+
 func TestAccFastlyDataSource_Datacenters(t *testing.T) {
 	resourceName := "data.fastly_datacenters.some"
 
@@ -32,6 +113,15 @@ func TestAccFastlyDataSource_Datacenters(t *testing.T) {
 					// then the test becomes flaky.
 				),
 			},
+			{
+				Config: testAccFastlyDataSourceDatacentersFilteredConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.fastly_datacenters.shields", "pops.0.shield"),
+					resource.TestCheckResourceAttrSet("data.fastly_datacenters.shields", "pops.0.distance"),
+					resource.TestCheckResourceAttrSet("data.fastly_datacenters.shields", "pops.0.latitude"),
+					resource.TestCheckResourceAttrSet("data.fastly_datacenters.shields", "pops.0.longitude"),
+				),
+			},
 		},
 	})
 }
@@ -68,3 +158,15 @@ const testAccFastlyDataSourceDatacentersConfig = `
 data "fastly_datacenters" "some" {
 }
 `
+
+const testAccFastlyDataSourceDatacentersFilteredConfig = `
+data "fastly_datacenters" "shields" {
+  group       = "Europe"
+  shield_only = true
+
+  near {
+    latitude  = 50.85
+    longitude = 4.35
+  }
+}
+`
@@ -0,0 +1,177 @@
+package fastly
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	v1 "github.com/fastly/go-fastly/v9/fastly/domains/v1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	domainV1Associated   = "associated"
+	domainV1Unassociated = "unassociated"
+)
+
+func dataSourceFastlyDomainsV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFastlyDomainsV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"association_status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only return domains that are associated with a service (`associated`) or that are not (`unassociated`).",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{domainV1Associated, domainV1Unassociated}, false)),
+			},
+			"domains": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching domains, sorted by FQDN.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Date and time in ISO 8601 format.",
+						},
+						"domain_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Domain Identifier (UUID).",
+						},
+						"fqdn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The fully-qualified domain name of the domain.",
+						},
+						"service_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The service_id associated with the domain, empty if there is no association.",
+						},
+						"updated_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Date and time in ISO 8601 format.",
+						},
+					},
+				},
+			},
+			"fqdn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return domains whose fully-qualified domain name contains this string.",
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IDs of the matching domains, in the same order as `domains`.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"service_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return domains associated with this service.",
+			},
+		},
+	}
+}
+
+func dataSourceFastlyDomainsV1Read(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	log.Printf("[DEBUG] Reading domains")
+
+	input := &v1.ListInput{
+		Limit: gofastly.ToPointer(100),
+	}
+	fqdn := d.Get("fqdn").(string)
+	if fqdn != "" {
+		input.FQDN = gofastly.ToPointer(fqdn)
+	}
+	serviceID := d.Get("service_id").(string)
+	if serviceID != "" {
+		input.ServiceID = gofastly.ToPointer(serviceID)
+	}
+
+	domains, err := listAllDomainsV1(conn, input)
+	if err != nil {
+		return diag.Errorf("error fetching domains: %s", err)
+	}
+	domains = filterDomainsV1(domains, fqdn, d.Get("association_status").(string))
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", fqdn, serviceID, d.Get("association_status").(string)))
+
+	ids := make([]string, 0, len(domains))
+	for _, domain := range domains {
+		ids = append(ids, domain.DomainID)
+	}
+	if err := d.Set("domains", flattenDomainsV1(domains)); err != nil {
+		return diag.Errorf("error setting domains: %s", err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return diag.Errorf("error setting domain IDs: %s", err)
+	}
+
+	return nil
+}
+
+// listAllDomainsV1 follows the cursor of the domains list until all pages
+// have been read.
+func listAllDomainsV1(conn *gofastly.Client, input *v1.ListInput) ([]v1.Data, error) {
+	var domains []v1.Data
+	for {
+		cl, err := v1.List(conn, input)
+		if err != nil {
+			return nil, err
+		}
+		domains = append(domains, cl.Data...)
+		if cl.Meta.NextCursor == "" {
+			return domains, nil
+		}
+		input.Cursor = gofastly.ToPointer(cl.Meta.NextCursor)
+	}
+}
+
+// filterDomainsV1 keeps the domains whose FQDN contains fqdn (the API match is
+// fuzzy) and which match the association status, if set, sorted by FQDN.
+func filterDomainsV1(domains []v1.Data, fqdn, associationStatus string) []v1.Data {
+	result := []v1.Data{}
+	for _, domain := range domains {
+		if !strings.Contains(strings.ToLower(domain.FQDN), strings.ToLower(fqdn)) {
+			continue
+		}
+		associated := gofastly.ToValue(domain.ServiceID) != ""
+		if associationStatus == domainV1Associated && !associated || associationStatus == domainV1Unassociated && associated {
+			continue
+		}
+		result = append(result, domain)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].FQDN < result[j].FQDN
+	})
+	return result
+}
+
+// flattenDomainsV1 models data into format suitable for saving to Terraform state.
+func flattenDomainsV1(domains []v1.Data) []map[string]any {
+	result := make([]map[string]any, 0, len(domains))
+	for _, domain := range domains {
+		result = append(result, map[string]any{
+			"created_at": domain.CreatedAt.Format(time.RFC3339),
+			"domain_id":  domain.DomainID,
+			"fqdn":       domain.FQDN,
+			"service_id": gofastly.ToValue(domain.ServiceID),
+			"updated_at": domain.UpdatedAt.Format(time.RFC3339),
+		})
+	}
+	return result
+}
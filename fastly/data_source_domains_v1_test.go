@@ -0,0 +1,80 @@
+package fastly
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	v1 "github.com/fastly/go-fastly/v9/fastly/domains/v1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestFilterDomainsV1(t *testing.T) {
+	domains := []v1.Data{
+		{DomainID: "3", FQDN: "www.example.com", ServiceID: gofastly.ToPointer("svc1")},
+		{DomainID: "1", FQDN: "api.example.com"},
+		{DomainID: "2", FQDN: "API.example.net", ServiceID: gofastly.ToPointer("svc2")},
+	}
+
+	for _, tc := range []struct {
+		name   string
+		fqdn   string
+		status string
+		want   []string
+	}{
+		{name: "all", want: []string{"2", "1", "3"}},
+		{name: "fqdn substring", fqdn: "example.com", want: []string{"1", "3"}},
+		{name: "fqdn is case insensitive", fqdn: "api", want: []string{"2", "1"}},
+		{name: "associated", status: domainV1Associated, want: []string{"2", "3"}},
+		{name: "unassociated", status: domainV1Unassociated, want: []string{"1"}},
+		{name: "fqdn and status", fqdn: "api", status: domainV1Unassociated, want: []string{"1"}},
+		{name: "no match", fqdn: "example.org", want: []string{}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := []string{}
+			for _, d := range filterDomainsV1(domains, tc.fqdn, tc.status) {
+				got = append(got, d.DomainID)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAccFastlyDataSourceDomainsV1_Config(t *testing.T) {
+	suffix := acctest.RandString(10)
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", suffix)
+	resourceName := "data.fastly_domains_v1.example"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDomainV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "fastly_domain_v1" "example" {
+				    fqdn = "%s"
+				}
+
+				data "fastly_domains_v1" "example" {
+				    fqdn               = "tf-%s"
+				    association_status = "unassociated"
+				    depends_on         = [fastly_domain_v1.example]
+				}
+				`, domainName, suffix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "domains.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "domains.0.fqdn", domainName),
+					resource.TestCheckResourceAttrPair(resourceName, "domains.0.domain_id", "fastly_domain_v1.example", "domain_id"),
+					resource.TestCheckResourceAttr(resourceName, "ids.#", "1"),
+				),
+			},
+		},
+	})
+}
@@ -2,31 +2,75 @@ package fastly
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"log"
+	"net/netip"
 	"sort"
+	"strings"
 
 	"github.com/fastly/terraform-provider-fastly/fastly/hashcode"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// ipRangesPrefixListDescription is the description of the entries of
+// `aws_prefix_list_entries`.
+const ipRangesPrefixListDescription = "Fastly"
+
 func dataSourceFastlyIPRanges() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceFastlyIPRangesRead,
 
 		Schema: map[string]*schema.Schema{
+			"address_family": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only return the CIDR blocks of this address family. One of `ipv4`, `ipv6`. Defaults to both.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"ipv4", "ipv6"}, false)),
+			},
+			"aggregate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to merge adjacent and overlapping CIDR blocks into the smallest equivalent list, e.g. for firewalls limiting the number of rules. Default `false`",
+			},
+			"aws_prefix_list_entries": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "A map of the CIDR blocks to a description, to use as the entries of an `aws_ec2_managed_prefix_list`. AWS prefix lists hold a single address family, so set `address_family` accordingly.",
+			},
 			"cidr_blocks": {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "The lexically ordered list of ipv4 CIDR blocks.",
 			},
+			"hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA-256 hash of the returned CIDR blocks, which only changes when the blocks do.",
+			},
 			"ipv6_cidr_blocks": {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "The lexically ordered list of ipv6 CIDR blocks.",
 			},
+			"json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A JSON document with the ipv4 CIDR blocks in `addresses` and the ipv6 CIDR blocks in `ipv6_addresses`, in the format of the Fastly public IP list.",
+			},
+			"nginx_allow": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "An nginx `allow` directive per CIDR block, one per line.",
+			},
 		},
 	}
 }
@@ -48,6 +92,22 @@ func dataSourceFastlyIPRangesRead(_ context.Context, d *schema.ResourceData, met
 
 	d.SetId(s)
 
+	switch d.Get("address_family").(string) {
+	case "ipv4":
+		ipv6addresses = []string{}
+	case "ipv6":
+		ipv4addresses = []string{}
+	}
+
+	if d.Get("aggregate").(bool) {
+		if ipv4addresses, err = aggregateCIDRs(ipv4addresses); err != nil {
+			return diag.Errorf("error aggregating ipv4 ranges: %s", err)
+		}
+		if ipv6addresses, err = aggregateCIDRs(ipv6addresses); err != nil {
+			return diag.Errorf("error aggregating ipv6 ranges: %s", err)
+		}
+	}
+
 	sort.Strings(ipv4addresses)
 	sort.Strings(ipv6addresses)
 
@@ -59,5 +119,65 @@ func dataSourceFastlyIPRangesRead(_ context.Context, d *schema.ResourceData, met
 		return diag.Errorf("error setting ipv6 ranges: %s", err)
 	}
 
+	formatted, err := formatIPRanges(ipv4addresses, ipv6addresses)
+	if err != nil {
+		return diag.Errorf("error formatting IP ranges: %s", err)
+	}
+	for _, key := range sortedKeys(formatted) {
+		if err := d.Set(key, formatted[key]); err != nil {
+			return diag.Errorf("error setting %s: %s", key, err)
+		}
+	}
+
 	return nil
 }
+
+// formatIPRanges returns the hash and the ready-made formats of sorted ipv4
+// and ipv6 CIDR blocks.
+func formatIPRanges(ipv4addresses, ipv6addresses []string) (map[string]any, error) {
+	all := append(append([]string{}, ipv4addresses...), ipv6addresses...)
+
+	sum := sha256.Sum256([]byte(strings.Join(all, "\n")))
+
+	document, err := json.Marshal(struct {
+		Addresses     []string `json:"addresses"`
+		IPv6Addresses []string `json:"ipv6_addresses"`
+	}{ipv4addresses, ipv6addresses})
+	if err != nil {
+		return nil, err
+	}
+
+	var nginx strings.Builder
+	entries := make(map[string]string, len(all))
+	for _, cidr := range all {
+		fmt.Fprintf(&nginx, "allow %s;\n", cidr)
+		entries[cidr] = ipRangesPrefixListDescription
+	}
+
+	return map[string]any{
+		"aws_prefix_list_entries": entries,
+		"hash":                    hex.EncodeToString(sum[:]),
+		"json":                    string(document),
+		"nginx_allow":             nginx.String(),
+	}, nil
+}
+
+// aggregateCIDRs returns the smallest list of CIDR blocks covering the same
+// addresses (see aggregatePrefixes). The result is sorted by address.
+func aggregateCIDRs(cidrs []string) ([]string, error) {
+	prefixes := make([]netip.Prefix, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		p, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, err
+		}
+		prefixes = append(prefixes, p.Masked())
+	}
+
+	aggregated := aggregatePrefixes(prefixes)
+	result := make([]string, 0, len(aggregated))
+	for _, p := range aggregated {
+		result = append(result, p.String())
+	}
+	return result, nil
+}
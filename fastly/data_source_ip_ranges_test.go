@@ -1,8 +1,10 @@
 package fastly
 
 import (
+	"encoding/json"
 	"fmt"
 	"net"
+	"reflect"
 	"sort"
 	"strconv"
 	"testing"
@@ -11,6 +13,101 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func TestAggregateCIDRs(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		cidrs []string
+		want  []string
+	}{
+		{
+			name:  "empty",
+			cidrs: []string{},
+			want:  []string{},
+		},
+		{
+			name:  "siblings",
+			cidrs: []string{"10.0.1.0/24", "10.0.0.0/24"},
+			want:  []string{"10.0.0.0/23"},
+		},
+		{
+			name:  "cascading",
+			cidrs: []string{"10.0.0.0/24", "10.0.1.0/24", "10.0.2.0/23", "10.0.4.0/22"},
+			want:  []string{"10.0.0.0/21"},
+		},
+		{
+			name:  "contained and duplicate",
+			cidrs: []string{"10.0.0.0/16", "10.0.3.0/24", "10.0.0.0/16", "10.1.0.1/32"},
+			want:  []string{"10.0.0.0/16", "10.1.0.1/32"},
+		},
+		{
+			name:  "adjacent but not siblings",
+			cidrs: []string{"10.0.1.0/24", "10.0.2.0/24"},
+			want:  []string{"10.0.1.0/24", "10.0.2.0/24"},
+		},
+		{
+			name:  "unmasked",
+			cidrs: []string{"10.0.0.1/24"},
+			want:  []string{"10.0.0.0/24"},
+		},
+		{
+			name:  "ipv6",
+			cidrs: []string{"2a04:4e42:200::/40", "2a04:4e42::/40", "2a04:4e42:100::/40", "2a04:4e42:300::/40"},
+			want:  []string{"2a04:4e42::/38"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := aggregateCIDRs(tc.cidrs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	if _, err := aggregateCIDRs([]string{"not a cidr"}); err == nil {
+		t.Error("expected an error for an invalid CIDR block")
+	}
+}
+
+func TestFormatIPRanges(t *testing.T) {
+	got, err := formatIPRanges([]string{"151.101.0.0/16", "23.235.32.0/20"}, []string{"2a04:4e40::/32"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "allow 151.101.0.0/16;\nallow 23.235.32.0/20;\nallow 2a04:4e40::/32;\n"; got["nginx_allow"] != want {
+		t.Errorf("nginx_allow: got %q, want %q", got["nginx_allow"], want)
+	}
+	if want := map[string]string{"151.101.0.0/16": "Fastly", "23.235.32.0/20": "Fastly", "2a04:4e40::/32": "Fastly"}; !reflect.DeepEqual(got["aws_prefix_list_entries"], want) {
+		t.Errorf("aws_prefix_list_entries: got %v, want %v", got["aws_prefix_list_entries"], want)
+	}
+
+	var document map[string][]string
+	if err := json.Unmarshal([]byte(got["json"].(string)), &document); err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string][]string{"addresses": {"151.101.0.0/16", "23.235.32.0/20"}, "ipv6_addresses": {"2a04:4e40::/32"}}; !reflect.DeepEqual(document, want) {
+		t.Errorf("json: got %v, want %v", document, want)
+	}
+
+	// The hash only depends on the blocks.
+	same, _ := formatIPRanges([]string{"151.101.0.0/16", "23.235.32.0/20"}, []string{"2a04:4e40::/32"})
+	other, _ := formatIPRanges([]string{"151.101.0.0/16"}, []string{"2a04:4e40::/32"})
+	if len(got["hash"].(string)) != 64 || got["hash"] != same["hash"] || got["hash"] == other["hash"] {
+		t.Errorf("unexpected hashes %q, %q, %q", got["hash"], same["hash"], other["hash"])
+	}
+
+	empty, err := formatIPRanges([]string{}, []string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if empty["json"] != `{"addresses":[],"ipv6_addresses":[]}` || empty["nginx_allow"] != "" {
+		t.Errorf("unexpected formats for no blocks: %v", empty)
+	}
+}
+
 func TestAccFastlyIPRanges_Config(t *testing.T) {
 	// NOTE: due to how providers are instantiated during ParallelTest
 	// there may be a case where some tests get polluted with an instance of the provider
@@ -23,6 +120,16 @@ func TestAccFastlyIPRanges_Config(t *testing.T) {
 				Config: testAccFastlyIPRangesConfig,
 				Check: resource.ComposeTestCheckFunc(
 					testAccFastlyIPRangesState("data.fastly_ip_ranges.some"),
+					resource.TestCheckResourceAttrSet("data.fastly_ip_ranges.some", "hash"),
+					resource.TestCheckResourceAttrSet("data.fastly_ip_ranges.some", "nginx_allow"),
+				),
+			},
+			{
+				Config: testAccFastlyIPRangesAggregatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fastly_ip_ranges.ipv4", "ipv6_cidr_blocks.#", "0"),
+					resource.TestCheckResourceAttrSet("data.fastly_ip_ranges.ipv4", "cidr_blocks.0"),
+					resource.TestCheckResourceAttrSet("data.fastly_ip_ranges.ipv4", "aws_prefix_list_entries.%"),
 				),
 			},
 		},
@@ -96,3 +203,10 @@ const testAccFastlyIPRangesConfig = `
 data "fastly_ip_ranges" "some" {
 }
 `
+
+const testAccFastlyIPRangesAggregatedConfig = `
+data "fastly_ip_ranges" "ipv4" {
+  address_family = "ipv4"
+  aggregate      = true
+}
+`
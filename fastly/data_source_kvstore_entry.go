@@ -0,0 +1,87 @@
+package fastly
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"unicode/utf8"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceFastlyKVStoreEntry() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFastlyKVStoreEntryRead,
+		Schema: map[string]*schema.Schema{
+			"encoding": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "text",
+				Description:  "How the value is returned. Can be `text` (the value must be valid UTF-8) or `base64` (for binary values). Default `text`.",
+				ValidateFunc: validation.StringInSlice([]string{"text", "base64"}, false),
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The key of the entry to read.",
+			},
+			"store_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "An alphanumeric string identifying the KV Store.",
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The value of the entry, encoded as set by `encoding`.",
+			},
+		},
+	}
+}
+
+func dataSourceFastlyKVStoreEntryRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	storeID := d.Get("store_id").(string)
+	key := d.Get("key").(string)
+
+	log.Printf("[DEBUG] Reading KV Store (%s) entry (%s)", storeID, key)
+
+	value, err := conn.GetKVStoreKey(&gofastly.GetKVStoreKeyInput{
+		StoreID: storeID,
+		Key:     key,
+	})
+	if err != nil {
+		return diag.Errorf("error fetching KV Store (%s) entry (%s): %s", storeID, key, err)
+	}
+
+	encoded, err := encodeKVStoreValue(value, d.Get("encoding").(string))
+	if err != nil {
+		return diag.Errorf("error reading KV Store (%s) entry (%s): %s", storeID, key, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", storeID, key))
+
+	if err := d.Set("value", encoded); err != nil {
+		return diag.Errorf("error setting value: %s", err)
+	}
+
+	return nil
+}
+
+// encodeKVStoreValue returns a KV Store value as text or base64.
+func encodeKVStoreValue(value, encoding string) (string, error) {
+	switch encoding {
+	case "base64":
+		return base64.StdEncoding.EncodeToString([]byte(value)), nil
+	default:
+		if !utf8.ValidString(value) {
+			return "", fmt.Errorf("value is not valid UTF-8, set `encoding = \"base64\"` to read binary values")
+		}
+		return value, nil
+	}
+}
@@ -0,0 +1,94 @@
+package fastly
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestEncodeKVStoreValue(t *testing.T) {
+	for name, testcase := range map[string]struct {
+		value    string
+		encoding string
+		want     string
+		wantErr  bool
+	}{
+		"text":          {value: "hello", encoding: "text", want: "hello"},
+		"base64":        {value: "hello", encoding: "base64", want: "aGVsbG8="},
+		"binary base64": {value: "\xff\x00", encoding: "base64", want: "/wA="},
+		"binary text":   {value: "\xff\x00", encoding: "text", wantErr: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, err := encodeKVStoreValue(testcase.value, testcase.encoding)
+			if (err != nil) != testcase.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != testcase.want {
+				t.Errorf("want %q, got %q", testcase.want, got)
+			}
+		})
+	}
+}
+
+func TestAccFastlyDataSourceKVStoreEntry_Config(t *testing.T) {
+	name := fmt.Sprintf("tf_%s", generateHex())
+	var storeID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFastlyDataSourceKVStoreEntryStore(name),
+				Check: func(s *terraform.State) error {
+					storeID = s.RootModule().Resources["fastly_kvstore.example"].Primary.ID
+					return nil
+				},
+			},
+			{
+				PreConfig: func() {
+					conn := testAccProvider.Meta().(*APIClient).conn
+					err := conn.InsertKVStoreKey(&gofastly.InsertKVStoreKeyInput{
+						StoreID: storeID,
+						Key:     "key1",
+						Value:   "value1",
+					})
+					if err != nil {
+						t.Fatalf("failed to insert KV Store key: %s", err)
+					}
+				},
+				Config: testAccFastlyDataSourceKVStoreEntryStore(name) + `
+data "fastly_kvstore_entry" "text" {
+  store_id = fastly_kvstore.example.id
+  key      = "key1"
+}
+
+data "fastly_kvstore_entry" "base64" {
+  store_id = fastly_kvstore.example.id
+  key      = "key1"
+  encoding = "base64"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fastly_kvstore_entry.text", "value", "value1"),
+					resource.TestCheckResourceAttr("data.fastly_kvstore_entry.base64", "value", "dmFsdWUx"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFastlyDataSourceKVStoreEntryStore(name string) string {
+	return strings.TrimSpace(fmt.Sprintf(`
+resource "fastly_kvstore" "example" {
+  name          = "%s"
+  force_destroy = true
+}
+`, name))
+}
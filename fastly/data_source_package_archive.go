@@ -0,0 +1,240 @@
+package fastly
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// packageArchiveModTime is the modification time given to every entry of a
+// package archive, so that the archive only changes when the files do.
+var packageArchiveModTime = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+func dataSourceFastlyPackageArchive() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFastlyPackageArchiveRead,
+
+		Schema: map[string]*schema.Schema{
+			"excludes": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Glob patterns (relative to `source_dir`, using `/` as separator) of files and directories to leave out of the package. `fastly.toml` and `bin/main.wasm` cannot be excluded.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A SHA512 hash of all files (in sorted order) within the package. This is the same value `fastly_package_hash` returns for the package.",
+			},
+			"output_path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path of the `.tar.gz` package to write. Missing parent directories are created.",
+			},
+			"output_size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The size of the written package in bytes.",
+			},
+			"package_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the top-level directory within the package. Defaults to the `name` in `fastly.toml`.",
+			},
+			"source_dir": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path to the Compute project directory. It must contain a `fastly.toml` manifest and a compiled `bin/main.wasm`.",
+			},
+		},
+	}
+}
+
+func dataSourceFastlyPackageArchiveRead(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+	sourceDir := d.Get("source_dir").(string)
+	outputPath := d.Get("output_path").(string)
+
+	log.Printf("[DEBUG] Building Package archive '%s' from '%s'", outputPath, sourceDir)
+
+	name := d.Get("package_name").(string)
+	if name == "" {
+		var manifest packageManifest
+		if _, err := toml.DecodeFile(filepath.Join(sourceDir, "fastly.toml"), &manifest); err != nil {
+			return diag.Errorf("failed to read package manifest: %s", err)
+		}
+		if manifest.Name == "" {
+			return diag.Errorf("package manifest '%s' has no name, set `package_name` instead", filepath.Join(sourceDir, "fastly.toml"))
+		}
+		name = manifest.Name
+	}
+
+	var excludes []string
+	if v, ok := d.GetOk("excludes"); ok {
+		excludes = buildStringSlice(v.(*schema.Set))
+	}
+
+	data, err := buildPackageArchive(os.DirFS(sourceDir), name, excludes)
+	if err != nil {
+		return diag.Errorf("failed to build package from '%s': %s", sourceDir, err)
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return diag.Errorf("failed to create a gzip reader: %s", err)
+	}
+
+	files, err := readFilesFromPackage(tar.NewReader(zr))
+	if err != nil {
+		return diag.Errorf("failed to read files within the package: %s", err)
+	}
+
+	hash, err := getFilesHash(files)
+	if err != nil {
+		return diag.Errorf("failed to generate hash from package files: %s", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(outputPath), 0o755); err != nil {
+		return diag.Errorf("failed to create output directory: %s", err)
+	}
+	if err := os.WriteFile(outputPath, data, 0o644); err != nil {
+		return diag.Errorf("failed to write package '%s': %s", outputPath, err)
+	}
+
+	d.SetId(hash)
+
+	if err := d.Set("hash", hash); err != nil {
+		return diag.Errorf("error setting package hash: %s", err)
+	}
+	if err := d.Set("output_size", len(data)); err != nil {
+		return diag.Errorf("error setting package size: %s", err)
+	}
+	if err := d.Set("package_name", name); err != nil {
+		return diag.Errorf("error setting package name: %s", err)
+	}
+
+	return nil
+}
+
+// buildPackageArchive builds a gzipped tar of every file within src, nested
+// under a top-level directory called name. The output is deterministic:
+// entries are sorted by path and have a fixed modification time, mode and
+// ownership, and the gzip header carries no timestamp.
+func buildPackageArchive(src fs.FS, name string, excludes []string) ([]byte, error) {
+	for _, required := range []string{"fastly.toml", "bin/main.wasm"} {
+		fi, err := fs.Stat(src, required)
+		if err != nil {
+			return nil, fmt.Errorf("required file '%s' not found: %w", required, err)
+		}
+		if !fi.Mode().IsRegular() {
+			return nil, fmt.Errorf("required file '%s' is not a regular file", required)
+		}
+	}
+
+	for _, pattern := range excludes {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid exclude pattern '%s': %w", pattern, err)
+		}
+	}
+
+	var paths []string
+	err := fs.WalkDir(src, ".", func(p string, e fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if p == "." {
+			return nil
+		}
+		if p != "fastly.toml" && p != "bin" && p != "bin/main.wasm" {
+			for _, pattern := range excludes {
+				if matched, _ := path.Match(pattern, p); !matched {
+					continue
+				}
+				if e.IsDir() {
+					return fs.SkipDir
+				}
+				return nil
+			}
+		}
+		if !e.IsDir() && !e.Type().IsRegular() {
+			return fmt.Errorf("'%s' is not a regular file or directory", p)
+		}
+		paths = append(paths, p)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(zw)
+
+	writeHeader := func(hdr *tar.Header) error {
+		hdr.ModTime = packageArchiveModTime
+		return tw.WriteHeader(hdr)
+	}
+
+	if err := writeHeader(&tar.Header{Name: name + "/", Typeflag: tar.TypeDir, Mode: 0o755}); err != nil {
+		return nil, err
+	}
+
+	var size int64
+	for _, p := range paths {
+		fi, err := fs.Stat(src, p)
+		if err != nil {
+			return nil, err
+		}
+
+		if fi.IsDir() {
+			if err := writeHeader(&tar.Header{Name: name + "/" + p + "/", Typeflag: tar.TypeDir, Mode: 0o755}); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		size += fi.Size()
+		if size > maxPackageSize {
+			return nil, errors.New("package size exceeded 100MB limit")
+		}
+
+		if err := writeHeader(&tar.Header{Name: name + "/" + p, Typeflag: tar.TypeReg, Mode: 0o644, Size: fi.Size()}); err != nil {
+			return nil, err
+		}
+		f, err := src.Open(p)
+		if err != nil {
+			return nil, err
+		}
+		_, err = io.Copy(tw, f)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
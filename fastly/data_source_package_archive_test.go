@@ -0,0 +1,136 @@
+package fastly
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestBuildPackageArchive(t *testing.T) {
+	src := fstest.MapFS{
+		"fastly.toml":   {Data: []byte("name = \"example\"\n"), ModTime: packageArchiveModTime.AddDate(10, 0, 0)},
+		"bin/main.wasm": {Data: []byte("\x00asm"), Mode: 0o755},
+		"src/main.rs":   {Data: []byte("fn main() {}\n")},
+		"target/debug":  {Data: []byte("ignored")},
+	}
+
+	data, err := buildPackageArchive(src, "example", []string{"target"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Changing metadata only must not change the archive.
+	src["fastly.toml"].ModTime = packageArchiveModTime
+	again, err := buildPackageArchive(src, "example", []string{"target"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !bytes.Equal(data, again) {
+		t.Errorf("expected archive to be deterministic")
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	tr := tar.NewReader(zr)
+	var names []string
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if !hdr.ModTime.Equal(packageArchiveModTime) || hdr.Uid != 0 || hdr.Gid != 0 || hdr.Uname != "" || hdr.Gname != "" {
+			t.Errorf("unexpected metadata for %s: %#v", hdr.Name, hdr)
+		}
+		names = append(names, hdr.Name)
+	}
+	want := []string{
+		"example/",
+		"example/bin/",
+		"example/bin/main.wasm",
+		"example/fastly.toml",
+		"example/src/",
+		"example/src/main.rs",
+	}
+	if diff := cmp.Diff(want, names); diff != "" {
+		t.Errorf("unexpected entries (-want +got):\n%s", diff)
+	}
+
+	for name, excludes := range map[string][]string{
+		"missing main.wasm": nil,
+		"invalid pattern":   {"["},
+	} {
+		t.Run(name, func(t *testing.T) {
+			src := fstest.MapFS{
+				"fastly.toml":   {Data: []byte("name = \"example\"\n")},
+				"bin/main.wasm": {Data: []byte("\x00asm")},
+			}
+			if excludes == nil {
+				delete(src, "bin/main.wasm")
+			}
+			if _, err := buildPackageArchive(src, "example", excludes); err == nil {
+				t.Errorf("expected error")
+			}
+		})
+	}
+}
+
+func TestAccFastlyPackageArchive_Config(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "bin"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "fastly.toml"), []byte("manifest_version = 3\nname = \"archive-test\"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "bin", "main.wasm"), []byte("\x00asm\x01\x00\x00\x00"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	output := filepath.Join(t.TempDir(), "pkg", "archive-test.tar.gz")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+        data "fastly_package_archive" "example" {
+          source_dir  = "%s"
+          output_path = "%s"
+        }
+
+        data "fastly_package_hash" "example" {
+          filename = data.fastly_package_archive.example.output_path
+        }
+        `, dir, output),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fastly_package_archive.example", "package_name", "archive-test"),
+					resource.TestCheckResourceAttrPair("data.fastly_package_archive.example", "hash", "data.fastly_package_hash.example", "hash"),
+					testAccFastlyPackageArchiveExists(output),
+				),
+			},
+		},
+	})
+}
+
+func testAccFastlyPackageArchiveExists(output string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if _, err := os.Stat(output); err != nil {
+			return fmt.Errorf("expected package to be written: %w", err)
+		}
+		return nil
+	}
+}
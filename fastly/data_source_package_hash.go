@@ -13,7 +13,12 @@ import (
 	"log"
 	"math/rand"
 	"os"
+	"path"
 	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,6 +29,12 @@ func dataSourceFastlyPackageHash() *schema.Resource {
 		ReadContext: dataSourceFastlyPackageHashRead,
 
 		Schema: map[string]*schema.Schema{
+			"authors": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The `authors` declared in the package's `fastly.toml` manifest.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"content": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -41,6 +52,45 @@ func dataSourceFastlyPackageHash() *schema.Resource {
 				Computed:    true,
 				Description: "A SHA512 hash of all files (in sorted order) within the package.",
 			},
+			"language": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The `language` declared in the package's `fastly.toml` manifest.",
+			},
+			"manifest_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The `manifest_version` declared in the package's `fastly.toml` manifest.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The `name` declared in the package's `fastly.toml` manifest.",
+			},
+			"setup_backends": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The names of the backends declared in the `setup` section of the package's `fastly.toml` manifest.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"setup_config_stores": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The names of the Config Stores declared in the `setup` section of the package's `fastly.toml` manifest.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"setup_kv_stores": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The names of the KV Stores declared in the `setup` section of the package's `fastly.toml` manifest.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"setup_secret_stores": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The names of the Secret Stores declared in the `setup` section of the package's `fastly.toml` manifest.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -80,14 +130,21 @@ func dataSourceFastlyPackageHashRead(_ context.Context, d *schema.ResourceData,
 		return diag.Errorf("failed to open package '%s': %s", pkg, err)
 	}
 
-	zr, err := gzip.NewReader(r)
+	files, err := readPackageFiles(r)
 	if err != nil {
-		return diag.Errorf("failed to create a gzip reader: %s", err)
+		return diag.FromErr(err)
 	}
 
-	files, err := readFilesFromPackage(tar.NewReader(zr))
+	// The manifest is optional for the hash, so failing to parse it must not
+	// prevent the hash from being computed.
+	var diags diag.Diagnostics
+	manifest, err := parsePackageManifest(files)
 	if err != nil {
-		return diag.Errorf("failed to read files within the package: %s", err)
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Failed to parse the package manifest",
+			Detail:   err.Error(),
+		})
 	}
 
 	hash, err := getFilesHash(files)
@@ -101,7 +158,25 @@ func dataSourceFastlyPackageHashRead(_ context.Context, d *schema.ResourceData,
 		return diag.Errorf("error setting package hash: %s", err)
 	}
 
-	return nil
+	if manifest == nil {
+		manifest = &packageManifest{}
+	}
+	for k, v := range map[string]any{
+		"authors":             manifest.Authors,
+		"language":            manifest.Language,
+		"manifest_version":    manifest.ManifestVersion,
+		"name":                manifest.Name,
+		"setup_backends":      sortedKeys(manifest.Setup.Backends),
+		"setup_config_stores": sortedKeys(manifest.Setup.ConfigStores),
+		"setup_kv_stores":     sortedKeys(manifest.Setup.KVStores),
+		"setup_secret_stores": sortedKeys(manifest.Setup.SecretStores),
+	} {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("error setting %s: %s", k, err)
+		}
+	}
+
+	return diags
 }
 
 const letterBytes = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
@@ -114,6 +189,20 @@ func randStringBytes(n int) string {
 	return string(b)
 }
 
+// readPackageFiles decompresses a package and reads all files within it.
+func readPackageFiles(r io.Reader) (map[string]*bytes.Buffer, error) {
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a gzip reader: %w", err)
+	}
+
+	files, err := readFilesFromPackage(tar.NewReader(zr))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read files within the package: %w", err)
+	}
+	return files, nil
+}
+
 // https://developer.fastly.com/learning/compute/#limitations-and-constraints
 const maxPackageSize int64 = 100000000 // 100MB in bytes
 
@@ -172,3 +261,73 @@ func getFilesHash(contents map[string]*bytes.Buffer) (string, error) {
 	}
 	return fmt.Sprintf("%x", h.Sum(nil)), nil
 }
+
+// packageManifest is the subset of a package's fastly.toml manifest the
+// provider uses.
+// https://www.fastly.com/documentation/reference/compute/fastly-toml/
+type packageManifest struct {
+	Authors         []string `toml:"authors"`
+	Language        string   `toml:"language"`
+	ManifestVersion int      `toml:"-"`
+	Name            string   `toml:"name"`
+	Setup           struct {
+		Backends     map[string]any `toml:"backends"`
+		ConfigStores map[string]any `toml:"config_stores"`
+		KVStores     map[string]any `toml:"kv_stores"`
+		SecretStores map[string]any `toml:"secret_stores"`
+	} `toml:"setup"`
+
+	// Older manifests quote the manifest version.
+	RawManifestVersion any `toml:"manifest_version"`
+}
+
+// parsePackageManifest parses the fastly.toml manifest found at the root of
+// the package, or within its top-level directory. It returns nil if the
+// package has no manifest.
+func parsePackageManifest(contents map[string]*bytes.Buffer) (*packageManifest, error) {
+	var name string
+	for k := range contents {
+		if path.Base(k) != "fastly.toml" || strings.Count(strings.TrimPrefix(k, "./"), "/") > 1 {
+			continue
+		}
+		// Prefer the shallowest manifest if there is more than one.
+		if name == "" || strings.Count(k, "/") < strings.Count(name, "/") {
+			name = k
+		}
+	}
+	if name == "" {
+		return nil, nil
+	}
+
+	var m packageManifest
+	// Bytes does not drain the buffer, so the files can still be hashed.
+	if _, err := toml.Decode(string(contents[name].Bytes()), &m); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", name, err)
+	}
+
+	switch v := m.RawManifestVersion.(type) {
+	case nil:
+	case int64:
+		m.ManifestVersion = int(v)
+	case string:
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid manifest_version %q in %s", v, name)
+		}
+		m.ManifestVersion = n
+	default:
+		return nil, fmt.Errorf("invalid manifest_version %v in %s", v, name)
+	}
+
+	return &m, nil
+}
+
+// sortedKeys returns the keys of a map in sorted order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
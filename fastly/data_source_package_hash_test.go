@@ -1,11 +1,14 @@
 package fastly
 
 import (
+	"bytes"
 	"encoding/base64"
 	"fmt"
 	"os"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -48,6 +51,79 @@ func testAccFastlyPackageHashState(n string) resource.TestCheckFunc {
 		if a["hash"] != "a763d3c88968ebc17691900d3c14306762296df8e47a1c2d7661cee0e0c5aa6d4c082a7c128d6e719fe333b73b46fe3ae32694716ccd2efa21f5d9f049ceec6d" {
 			return fmt.Errorf("unexpected package hash: %s", a["hash"])
 		}
+		if a["name"] != "wasm-test" || a["language"] != "rust" || a["authors.0"] != "fastly@fastly.com" {
+			return fmt.Errorf("unexpected package manifest attributes: %#v", a)
+		}
 		return nil
 	}
 }
+
+func TestParsePackageManifest(t *testing.T) {
+	for name, testcase := range map[string]struct {
+		files   map[string]string
+		want    *packageManifest
+		wantErr bool
+	}{
+		"no manifest": {
+			files: map[string]string{"pkg/bin/main.wasm": ""},
+		},
+		"top-level directory": {
+			files: map[string]string{
+				"pkg/bin/main.wasm": "",
+				"pkg/fastly.toml": `
+authors = ["a@example.com"]
+language = "rust"
+manifest_version = 3
+name = "pkg"
+
+[setup.backends.origin]
+address = "example.com"
+
+[setup.config_stores.config]
+[setup.kv_stores.kv]
+[setup.secret_stores.secrets]
+`,
+			},
+			want: func() *packageManifest {
+				m := &packageManifest{
+					Authors:            []string{"a@example.com"},
+					Language:           "rust",
+					ManifestVersion:    3,
+					Name:               "pkg",
+					RawManifestVersion: int64(3),
+				}
+				m.Setup.Backends = map[string]any{"origin": map[string]any{"address": "example.com"}}
+				m.Setup.ConfigStores = map[string]any{"config": map[string]any{}}
+				m.Setup.KVStores = map[string]any{"kv": map[string]any{}}
+				m.Setup.SecretStores = map[string]any{"secrets": map[string]any{}}
+				return m
+			}(),
+		},
+		"quoted manifest version": {
+			files: map[string]string{"fastly.toml": `manifest_version = "2"`},
+			want:  &packageManifest{ManifestVersion: 2, RawManifestVersion: "2"},
+		},
+		"nested manifests are ignored": {
+			files: map[string]string{"pkg/vendor/fastly.toml": `name = "vendored"`},
+		},
+		"invalid manifest": {
+			files:   map[string]string{"fastly.toml": `name = `},
+			wantErr: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			contents := make(map[string]*bytes.Buffer)
+			for k, v := range testcase.files {
+				contents[k] = bytes.NewBufferString(v)
+			}
+
+			got, err := parsePackageManifest(contents)
+			if (err != nil) != testcase.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(testcase.want, got); diff != "" {
+				t.Errorf("unexpected manifest (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,383 @@
+package fastly
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// serviceDataSourceBlocks are the service blocks returned in full by the
+// fastly_service data source. Their schemas are copied from the
+// fastly_service_vcl resource, which is a superset of fastly_service_compute.
+var serviceDataSourceBlocks = []string{"acl", "backend", "dictionary", "domain", "dynamicsnippet", "snippet"}
+
+func dataSourceFastlyService() *schema.Resource {
+	s := &schema.Resource{
+		ReadContext: dataSourceFastlyServiceRead,
+		Schema: map[string]*schema.Schema{
+			"active_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The currently active version of the service, or `0` if no version is active.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the service.",
+			},
+			"logging": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The logging endpoints of the service version, sorted by type and name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the logging endpoint.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The block defining the logging endpoint in a service resource, e.g. `logging_s3`.",
+						},
+					},
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the service.",
+			},
+			"service_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the service.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the service. One of `vcl`, `wasm`.",
+			},
+			"version": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				Description:      "The version of the service to read. Defaults to the active version, or to the latest version if no version is active.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+			},
+			"version_comment": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the service version.",
+			},
+		},
+	}
+
+	vcl := resourceServiceVCL()
+	for _, key := range serviceDataSourceBlocks {
+		s.Schema[key] = computedSchema(vcl.Schema[key])
+	}
+
+	return s
+}
+
+func dataSourceFastlyServiceRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	serviceID := d.Get("service_id").(string)
+	log.Printf("[DEBUG] Reading service (%s)", serviceID)
+
+	s, err := conn.GetServiceDetails(&gofastly.GetServiceInput{
+		ServiceID: serviceID,
+	})
+	if err != nil {
+		return diag.Errorf("error fetching service (%s): %s", serviceID, err)
+	}
+	if s.DeletedAt != nil {
+		return diag.Errorf("service (%s) has been deleted", serviceID)
+	}
+
+	activeVersion := 0
+	if s.ActiveVersion != nil {
+		activeVersion = gofastly.ToValue(s.ActiveVersion.Number)
+	}
+
+	serviceVersion := d.Get("version").(int)
+	if serviceVersion == 0 {
+		serviceVersion = activeVersion
+	}
+	if serviceVersion == 0 && s.Version != nil {
+		serviceVersion = gofastly.ToValue(s.Version.Number)
+	}
+	if serviceVersion == 0 {
+		return diag.Errorf("service (%s) has no version to read", serviceID)
+	}
+
+	version, err := conn.GetVersion(&gofastly.GetVersionInput{
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion,
+	})
+	if err != nil {
+		return diag.Errorf("error fetching service (%s) version (%d): %s", serviceID, serviceVersion, err)
+	}
+
+	serviceType := gofastly.ToValue(s.Type)
+	blocks, err := readServiceBlocks(conn, serviceID, serviceVersion, serviceType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	logging, err := readServiceLoggingNames(conn, serviceID, serviceVersion)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s-%d", serviceID, serviceVersion))
+
+	values := map[string]any{
+		"active_version":  activeVersion,
+		"comment":         gofastly.ToValue(s.Comment),
+		"logging":         logging,
+		"name":            gofastly.ToValue(s.Name),
+		"type":            serviceType,
+		"version":         serviceVersion,
+		"version_comment": gofastly.ToValue(version.Comment),
+	}
+	for key, value := range blocks {
+		values[key] = value
+	}
+	for _, key := range sortedKeys(values) {
+		if err := d.Set(key, values[key]); err != nil {
+			return diag.Errorf("error setting %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
+
+// readServiceBlocks returns the serviceDataSourceBlocks of a service version,
+// flattened the same way as by the service resources. The blocks that are
+// only available to VCL services are empty for Compute services.
+func readServiceBlocks(conn *gofastly.Client, serviceID string, serviceVersion int, serviceType string) (map[string][]map[string]any, error) {
+	sa := ServiceMetadata{serviceType}
+	blocks := map[string][]map[string]any{
+		"acl":            {},
+		"dynamicsnippet": {},
+		"snippet":        {},
+	}
+
+	domains, err := conn.ListDomains(&gofastly.ListDomainsInput{
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error looking up Domains for (%s), version (%v): %s", serviceID, serviceVersion, err)
+	}
+	blocks["domain"] = flattenDomains(domains)
+
+	backends, err := conn.ListBackends(&gofastly.ListBackendsInput{
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error looking up Backends for (%s), version (%v): %s", serviceID, serviceVersion, err)
+	}
+	blocks["backend"] = flattenBackend(backends, sa)
+
+	dictionaries, err := conn.ListDictionaries(&gofastly.ListDictionariesInput{
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error looking up Dictionaries for (%s), version (%v): %s", serviceID, serviceVersion, err)
+	}
+	blocks["dictionary"] = flattenDictionaries(dictionaries)
+
+	if serviceType != ServiceTypeVCL {
+		return blocks, nil
+	}
+
+	acls, err := conn.ListACLs(&gofastly.ListACLsInput{
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error looking up ACLs for (%s), version (%v): %s", serviceID, serviceVersion, err)
+	}
+	blocks["acl"] = flattenACLs(acls)
+
+	snippets, err := conn.ListSnippets(&gofastly.ListSnippetsInput{
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error looking up VCL Snippets for (%s), version (%v): %s", serviceID, serviceVersion, err)
+	}
+	blocks["snippet"] = flattenSnippets(snippets)
+	blocks["dynamicsnippet"] = flattenDynamicSnippets(snippets)
+
+	return blocks, nil
+}
+
+// serviceLoggingListers list the logging endpoints of a service version, keyed
+// by the block defining them in the service resources.
+var serviceLoggingListers = map[string]func(conn *gofastly.Client, serviceID string, serviceVersion int) ([]map[string]any, error){
+	"logging_bigquery": func(conn *gofastly.Client, serviceID string, serviceVersion int) ([]map[string]any, error) {
+		l, err := conn.ListBigQueries(&gofastly.ListBigQueriesInput{ServiceID: serviceID, ServiceVersion: serviceVersion})
+		return flattenLoggingList(l, err, flattenBigQuery)
+	},
+	"logging_blobstorage": func(conn *gofastly.Client, serviceID string, serviceVersion int) ([]map[string]any, error) {
+		l, err := conn.ListBlobStorages(&gofastly.ListBlobStoragesInput{ServiceID: serviceID, ServiceVersion: serviceVersion})
+		return flattenLoggingList(l, err, func(r []*gofastly.BlobStorage) []map[string]any { return flattenBlobStorages(r, nil) })
+	},
+	"logging_cloudfiles": func(conn *gofastly.Client, serviceID string, serviceVersion int) ([]map[string]any, error) {
+		l, err := conn.ListCloudfiles(&gofastly.ListCloudfilesInput{ServiceID: serviceID, ServiceVersion: serviceVersion})
+		return flattenLoggingList(l, err, func(r []*gofastly.Cloudfiles) []map[string]any { return flattenCloudfiles(r, nil) })
+	},
+	"logging_datadog": func(conn *gofastly.Client, serviceID string, serviceVersion int) ([]map[string]any, error) {
+		l, err := conn.ListDatadog(&gofastly.ListDatadogInput{ServiceID: serviceID, ServiceVersion: serviceVersion})
+		return flattenLoggingList(l, err, flattenDatadog)
+	},
+	"logging_digitalocean": func(conn *gofastly.Client, serviceID string, serviceVersion int) ([]map[string]any, error) {
+		l, err := conn.ListDigitalOceans(&gofastly.ListDigitalOceansInput{ServiceID: serviceID, ServiceVersion: serviceVersion})
+		return flattenLoggingList(l, err, func(r []*gofastly.DigitalOcean) []map[string]any { return flattenDigitalOcean(r, nil) })
+	},
+	"logging_elasticsearch": func(conn *gofastly.Client, serviceID string, serviceVersion int) ([]map[string]any, error) {
+		l, err := conn.ListElasticsearch(&gofastly.ListElasticsearchInput{ServiceID: serviceID, ServiceVersion: serviceVersion})
+		return flattenLoggingList(l, err, flattenElasticsearch)
+	},
+	"logging_ftp": func(conn *gofastly.Client, serviceID string, serviceVersion int) ([]map[string]any, error) {
+		l, err := conn.ListFTPs(&gofastly.ListFTPsInput{ServiceID: serviceID, ServiceVersion: serviceVersion})
+		return flattenLoggingList(l, err, func(r []*gofastly.FTP) []map[string]any { return flattenFTP(r, nil) })
+	},
+	"logging_gcs": func(conn *gofastly.Client, serviceID string, serviceVersion int) ([]map[string]any, error) {
+		l, err := conn.ListGCSs(&gofastly.ListGCSsInput{ServiceID: serviceID, ServiceVersion: serviceVersion})
+		return flattenLoggingList(l, err, func(r []*gofastly.GCS) []map[string]any { return flattenGCS(r, nil) })
+	},
+	"logging_googlepubsub": func(conn *gofastly.Client, serviceID string, serviceVersion int) ([]map[string]any, error) {
+		l, err := conn.ListPubsubs(&gofastly.ListPubsubsInput{ServiceID: serviceID, ServiceVersion: serviceVersion})
+		return flattenLoggingList(l, err, flattenGooglePubSub)
+	},
+	"logging_grafanacloudlogs": func(conn *gofastly.Client, serviceID string, serviceVersion int) ([]map[string]any, error) {
+		l, err := conn.ListGrafanaCloudLogs(&gofastly.ListGrafanaCloudLogsInput{ServiceID: serviceID, ServiceVersion: serviceVersion})
+		return flattenLoggingList(l, err, flattenGrafanaCloudLogs)
+	},
+	"logging_heroku": func(conn *gofastly.Client, serviceID string, serviceVersion int) ([]map[string]any, error) {
+		l, err := conn.ListHerokus(&gofastly.ListHerokusInput{ServiceID: serviceID, ServiceVersion: serviceVersion})
+		return flattenLoggingList(l, err, flattenHeroku)
+	},
+	"logging_honeycomb": func(conn *gofastly.Client, serviceID string, serviceVersion int) ([]map[string]any, error) {
+		l, err := conn.ListHoneycombs(&gofastly.ListHoneycombsInput{ServiceID: serviceID, ServiceVersion: serviceVersion})
+		return flattenLoggingList(l, err, flattenHoneycomb)
+	},
+	"logging_https": func(conn *gofastly.Client, serviceID string, serviceVersion int) ([]map[string]any, error) {
+		l, err := conn.ListHTTPS(&gofastly.ListHTTPSInput{ServiceID: serviceID, ServiceVersion: serviceVersion})
+		return flattenLoggingList(l, err, flattenHTTPS)
+	},
+	"logging_kafka": func(conn *gofastly.Client, serviceID string, serviceVersion int) ([]map[string]any, error) {
+		l, err := conn.ListKafkas(&gofastly.ListKafkasInput{ServiceID: serviceID, ServiceVersion: serviceVersion})
+		return flattenLoggingList(l, err, flattenKafka)
+	},
+	"logging_kinesis": func(conn *gofastly.Client, serviceID string, serviceVersion int) ([]map[string]any, error) {
+		l, err := conn.ListKinesis(&gofastly.ListKinesisInput{ServiceID: serviceID, ServiceVersion: serviceVersion})
+		return flattenLoggingList(l, err, flattenKinesis)
+	},
+	"logging_logentries": func(conn *gofastly.Client, serviceID string, serviceVersion int) ([]map[string]any, error) {
+		l, err := conn.ListLogentries(&gofastly.ListLogentriesInput{ServiceID: serviceID, ServiceVersion: serviceVersion})
+		return flattenLoggingList(l, err, flattenLogentries)
+	},
+	"logging_loggly": func(conn *gofastly.Client, serviceID string, serviceVersion int) ([]map[string]any, error) {
+		l, err := conn.ListLoggly(&gofastly.ListLogglyInput{ServiceID: serviceID, ServiceVersion: serviceVersion})
+		return flattenLoggingList(l, err, flattenLoggly)
+	},
+	"logging_logshuttle": func(conn *gofastly.Client, serviceID string, serviceVersion int) ([]map[string]any, error) {
+		l, err := conn.ListLogshuttles(&gofastly.ListLogshuttlesInput{ServiceID: serviceID, ServiceVersion: serviceVersion})
+		return flattenLoggingList(l, err, flattenLogshuttle)
+	},
+	"logging_newrelic": func(conn *gofastly.Client, serviceID string, serviceVersion int) ([]map[string]any, error) {
+		l, err := conn.ListNewRelic(&gofastly.ListNewRelicInput{ServiceID: serviceID, ServiceVersion: serviceVersion})
+		return flattenLoggingList(l, err, flattenNewRelic)
+	},
+	"logging_newrelicotlp": func(conn *gofastly.Client, serviceID string, serviceVersion int) ([]map[string]any, error) {
+		l, err := conn.ListNewRelicOTLP(&gofastly.ListNewRelicOTLPInput{ServiceID: serviceID, ServiceVersion: serviceVersion})
+		return flattenLoggingList(l, err, flattenNewRelicOTLP)
+	},
+	"logging_openstack": func(conn *gofastly.Client, serviceID string, serviceVersion int) ([]map[string]any, error) {
+		l, err := conn.ListOpenstack(&gofastly.ListOpenstackInput{ServiceID: serviceID, ServiceVersion: serviceVersion})
+		return flattenLoggingList(l, err, func(r []*gofastly.Openstack) []map[string]any { return flattenOpenstack(r, nil) })
+	},
+	"logging_papertrail": func(conn *gofastly.Client, serviceID string, serviceVersion int) ([]map[string]any, error) {
+		l, err := conn.ListPapertrails(&gofastly.ListPapertrailsInput{ServiceID: serviceID, ServiceVersion: serviceVersion})
+		return flattenLoggingList(l, err, flattenPapertrails)
+	},
+	"logging_s3": func(conn *gofastly.Client, serviceID string, serviceVersion int) ([]map[string]any, error) {
+		l, err := conn.ListS3s(&gofastly.ListS3sInput{ServiceID: serviceID, ServiceVersion: serviceVersion})
+		return flattenLoggingList(l, err, func(r []*gofastly.S3) []map[string]any { return flattenS3s(r, nil) })
+	},
+	"logging_scalyr": func(conn *gofastly.Client, serviceID string, serviceVersion int) ([]map[string]any, error) {
+		l, err := conn.ListScalyrs(&gofastly.ListScalyrsInput{ServiceID: serviceID, ServiceVersion: serviceVersion})
+		return flattenLoggingList(l, err, flattenScalyr)
+	},
+	"logging_sftp": func(conn *gofastly.Client, serviceID string, serviceVersion int) ([]map[string]any, error) {
+		l, err := conn.ListSFTPs(&gofastly.ListSFTPsInput{ServiceID: serviceID, ServiceVersion: serviceVersion})
+		return flattenLoggingList(l, err, func(r []*gofastly.SFTP) []map[string]any { return flattenSFTP(r, nil) })
+	},
+	"logging_splunk": func(conn *gofastly.Client, serviceID string, serviceVersion int) ([]map[string]any, error) {
+		l, err := conn.ListSplunks(&gofastly.ListSplunksInput{ServiceID: serviceID, ServiceVersion: serviceVersion})
+		return flattenLoggingList(l, err, flattenSplunks)
+	},
+	"logging_sumologic": func(conn *gofastly.Client, serviceID string, serviceVersion int) ([]map[string]any, error) {
+		l, err := conn.ListSumologics(&gofastly.ListSumologicsInput{ServiceID: serviceID, ServiceVersion: serviceVersion})
+		return flattenLoggingList(l, err, flattenSumologics)
+	},
+	"logging_syslog": func(conn *gofastly.Client, serviceID string, serviceVersion int) ([]map[string]any, error) {
+		l, err := conn.ListSyslogs(&gofastly.ListSyslogsInput{ServiceID: serviceID, ServiceVersion: serviceVersion})
+		return flattenLoggingList(l, err, flattenSyslogs)
+	},
+}
+
+// flattenLoggingList passes the result of a logging endpoint List call through
+// its flatten function.
+func flattenLoggingList[T any](remoteState []*T, err error, flatten func([]*T) []map[string]any) ([]map[string]any, error) {
+	if err != nil {
+		return nil, err
+	}
+	return flatten(remoteState), nil
+}
+
+// readServiceLoggingNames returns the type and name of each logging endpoint
+// of a service version.
+func readServiceLoggingNames(conn *gofastly.Client, serviceID string, serviceVersion int) ([]map[string]any, error) {
+	result := []map[string]any{}
+	for _, loggingType := range sortedKeys(serviceLoggingListers) {
+		endpoints, err := serviceLoggingListers[loggingType](conn, serviceID, serviceVersion)
+		if err != nil {
+			return nil, fmt.Errorf("error looking up %s endpoints for (%s), version (%v): %s", loggingType, serviceID, serviceVersion, err)
+		}
+		result = append(result, flattenLoggingNames(loggingType, endpoints)...)
+	}
+	return result, nil
+}
+
+// flattenLoggingNames models the names of flattened logging endpoints into
+// format suitable for saving to Terraform state, sorted by name.
+func flattenLoggingNames(loggingType string, endpoints []map[string]any) []map[string]any {
+	result := make([]map[string]any, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		name, _ := endpoint["name"].(string)
+		result = append(result, map[string]any{
+			"name": name,
+			"type": loggingType,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i]["name"].(string) < result[j]["name"].(string)
+	})
+	return result
+}
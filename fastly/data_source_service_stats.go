@@ -0,0 +1,239 @@
+package fastly
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/fastly/terraform-provider-fastly/fastly/hashcode"
+)
+
+func dataSourceFastlyServiceStats() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFastlyServiceStatsRead,
+		Schema: map[string]*schema.Schema{
+			"by": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "day",
+				Description:      "The duration of the sample windows. One of `minute`, `hour` or `day`. Default `day`",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"minute", "hour", "day"}, false)),
+			},
+			"fields": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The names of the stats fields to aggregate, e.g. `requests`, `bandwidth` or `hit_ratio`. See the [list of fields](https://www.fastly.com/documentation/reference/api/metrics-stats/historical-stats/).",
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+				},
+			},
+			"from": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The start of the time range, inclusive. Either a date and time in RFC 3339 format, a Unix timestamp or a relative time such as `1 week ago`. Defaults to the API default.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only report the stats of this region, e.g. `usa` or `europe`. Defaults to all the regions.",
+			},
+			"samples": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of sample windows in the time range.",
+			},
+			"service_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the service to report the stats of.",
+			},
+			"stats": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The aggregates of each field over the sample windows, in the order of `fields`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"average": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The average value of the field per sample window.",
+						},
+						"field": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the field.",
+						},
+						"max": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The highest value of the field in a sample window.",
+						},
+						"min": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The lowest value of the field in a sample window.",
+						},
+						"p95": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The 95th percentile of the values of the field per sample window.",
+						},
+						"sum": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The sum of the values of the field over the time range.",
+						},
+					},
+				},
+			},
+			"to": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The end of the time range, exclusive. Either a date and time in RFC 3339 format, a Unix timestamp or a relative time such as `now`. Defaults to the API default.",
+			},
+		},
+	}
+}
+
+// serviceStatsResponse is the response of the stats API for a single service.
+// The samples are decoded generically, so that any field can be aggregated.
+type serviceStatsResponse struct {
+	Data    []map[string]any `json:"data"`
+	Message string           `json:"msg"`
+	Status  string           `json:"status"`
+}
+
+func dataSourceFastlyServiceStatsRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	serviceID := d.Get("service_id").(string)
+	fields := buildStringList(d.Get("fields").([]any))
+
+	log.Printf("[DEBUG] Reading stats of service (%s)", serviceID)
+
+	input := &gofastly.GetStatsInput{
+		By:      gofastly.ToPointer(d.Get("by").(string)),
+		Service: gofastly.ToPointer(serviceID),
+	}
+	if v := d.Get("from").(string); v != "" {
+		input.From = gofastly.ToPointer(expandStatsTime(v))
+	}
+	if v := d.Get("to").(string); v != "" {
+		input.To = gofastly.ToPointer(expandStatsTime(v))
+	}
+	if v := d.Get("region").(string); v != "" {
+		// An unknown region silently yields no samples, so it is checked first.
+		regions, err := conn.GetRegions()
+		if err != nil {
+			return diag.Errorf("error fetching stats regions: %s", err)
+		}
+		if !slices.Contains(regions.Data, v) {
+			return diag.Errorf("unknown stats region %q, expected one of: %s", v, strings.Join(regions.Data, ", "))
+		}
+		input.Region = gofastly.ToPointer(v)
+	}
+
+	var resp serviceStatsResponse
+	if err := conn.GetStatsJSON(input, &resp); err != nil {
+		return diag.Errorf("error fetching stats of service (%s): %s", serviceID, err)
+	}
+	if resp.Status != "" && resp.Status != "success" {
+		return diag.Errorf("error fetching stats of service (%s): %s", serviceID, resp.Message)
+	}
+
+	stats, err := aggregateServiceStats(resp.Data, fields)
+	if err != nil {
+		return diag.Errorf("error aggregating stats of service (%s): %s", serviceID, err)
+	}
+
+	hashBase, _ := json.Marshal([]any{input, stats})
+	d.SetId(strconv.Itoa(hashcode.String(string(hashBase))))
+
+	if err := d.Set("samples", len(resp.Data)); err != nil {
+		return diag.Errorf("error setting samples: %s", err)
+	}
+	if err := d.Set("stats", stats); err != nil {
+		return diag.Errorf("error setting stats: %s", err)
+	}
+
+	return nil
+}
+
+// aggregateServiceStats computes the aggregates of each field over the samples.
+// Samples missing a field count as zero, but a field missing from all the
+// samples is most likely a typo and is reported as an error.
+func aggregateServiceStats(samples []map[string]any, fields []string) ([]map[string]any, error) {
+	result := make([]map[string]any, 0, len(fields))
+	for _, field := range fields {
+		values := make([]float64, 0, len(samples))
+		found := false
+		for _, sample := range samples {
+			v, ok := sample[field]
+			if !ok {
+				values = append(values, 0)
+				continue
+			}
+			found = true
+			switch v := v.(type) {
+			case float64:
+				values = append(values, v)
+			case nil:
+				values = append(values, 0)
+			default:
+				return nil, fmt.Errorf("field %q is not numeric", field)
+			}
+		}
+		if len(samples) > 0 && !found {
+			return nil, fmt.Errorf("unknown field %q", field)
+		}
+
+		aggregates := aggregateStatsValues(values)
+		aggregates["field"] = field
+		result = append(result, aggregates)
+	}
+	return result, nil
+}
+
+// aggregateStatsValues returns the sum, average, minimum, maximum and 95th
+// percentile of the values. The percentile uses the nearest-rank method.
+func aggregateStatsValues(values []float64) map[string]any {
+	result := map[string]any{
+		"average": 0.0,
+		"max":     0.0,
+		"min":     0.0,
+		"p95":     0.0,
+		"sum":     0.0,
+	}
+	if len(values) == 0 {
+		return result
+	}
+
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+
+	var sum float64
+	for _, v := range sorted {
+		sum += v
+	}
+	rank := int(math.Ceil(0.95 * float64(len(sorted))))
+
+	result["average"] = sum / float64(len(sorted))
+	result["max"] = sorted[len(sorted)-1]
+	result["min"] = sorted[0]
+	result["p95"] = sorted[rank-1]
+	result["sum"] = sum
+	return result
+}
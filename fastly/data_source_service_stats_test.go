@@ -0,0 +1,94 @@
+package fastly
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAggregateServiceStats(t *testing.T) {
+	samples := []map[string]any{}
+	for i := 1; i <= 20; i++ {
+		samples = append(samples, map[string]any{
+			"requests":  float64(i * 10),
+			"hit_ratio": nil,
+		})
+	}
+	samples[0]["hit_ratio"] = 0.5
+	delete(samples[1], "requests")
+
+	got, err := aggregateServiceStats(samples, []string{"requests", "hit_ratio"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []map[string]any{
+		{"field": "requests", "sum": 2080.0, "average": 104.0, "min": 0.0, "max": 200.0, "p95": 190.0},
+		{"field": "hit_ratio", "sum": 0.5, "average": 0.025, "min": 0.0, "max": 0.5, "p95": 0.0},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+
+	if _, err := aggregateServiceStats(samples, []string{"reqeusts"}); err == nil {
+		t.Error("expected an error for an unknown field")
+	}
+	if _, err := aggregateServiceStats([]map[string]any{{"service_id": "abc"}}, []string{"service_id"}); err == nil {
+		t.Error("expected an error for a non-numeric field")
+	}
+
+	got, err = aggregateServiceStats(nil, []string{"requests"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []map[string]any{{"field": "requests", "sum": 0.0, "average": 0.0, "min": 0.0, "max": 0.0, "p95": 0.0}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+}
+
+func TestAccFastlyDataSourceServiceStats_Config(t *testing.T) {
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domain := fmt.Sprintf("fastly-test.%s.com", name)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "fastly_service_vcl" "example" {
+  name = "%s"
+
+  domain {
+    name = "%s"
+  }
+
+  backend {
+    address = "httpbin.org"
+    name    = "httpbin"
+  }
+
+  force_destroy = true
+}
+
+data "fastly_service_stats" "example" {
+  service_id = fastly_service_vcl.example.id
+  from       = "1 day ago"
+  by         = "hour"
+  fields     = ["requests", "bandwidth"]
+}
+`, name, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fastly_service_stats.example", "stats.#", "2"),
+					resource.TestCheckResourceAttr("data.fastly_service_stats.example", "stats.0.field", "requests"),
+					resource.TestCheckResourceAttr("data.fastly_service_stats.example", "stats.1.field", "bandwidth"),
+					resource.TestCheckResourceAttrSet("data.fastly_service_stats.example", "samples"),
+				),
+			},
+		},
+	})
+}
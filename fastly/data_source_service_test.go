@@ -0,0 +1,106 @@
+package fastly
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestServiceLoggingListers(t *testing.T) {
+	for _, r := range []string{"fastly_service_vcl", "fastly_service_compute"} {
+		for key := range Provider().ResourcesMap[r].Schema {
+			if !strings.HasPrefix(key, "logging_") {
+				continue
+			}
+			if _, ok := serviceLoggingListers[key]; !ok {
+				t.Errorf("%s block %s is missing from serviceLoggingListers", r, key)
+			}
+		}
+	}
+}
+
+func TestFlattenLoggingNames(t *testing.T) {
+	got := flattenLoggingNames("logging_s3", []map[string]any{
+		{"name": "b", "bucket_name": "logs"},
+		{"name": "a", "bucket_name": "logs"},
+	})
+	want := []map[string]any{
+		{"name": "a", "type": "logging_s3"},
+		{"name": "b", "type": "logging_s3"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestAccFastlyDataSourceService_Config(t *testing.T) {
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domain := fmt.Sprintf("fastly-test.%s.com", name)
+	resourceName := "data.fastly_service.example"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckServiceVCLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "fastly_service_vcl" "example" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  backend {
+    address = "httpbin.org"
+    name    = "httpbin"
+  }
+
+  dictionary {
+    name = "example_dictionary"
+  }
+
+  logging_syslog {
+    name    = "example_syslog"
+    address = "syslog.example.com"
+  }
+
+  force_destroy = true
+}
+
+data "fastly_service" "example" {
+  service_id = fastly_service_vcl.example.id
+  depends_on = [fastly_service_vcl.example]
+}
+`, name, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "type", "vcl"),
+					resource.TestCheckResourceAttrPair(resourceName, "version", "fastly_service_vcl.example", "active_version"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "domain.*", map[string]string{
+						"name":    domain,
+						"comment": "tf-testing-domain",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "backend.*", map[string]string{
+						"name":    "httpbin",
+						"address": "httpbin.org",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "dictionary.*", map[string]string{
+						"name": "example_dictionary",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "logging.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging.0.name", "example_syslog"),
+					resource.TestCheckResourceAttr(resourceName, "logging.0.type", "logging_syslog"),
+				),
+			},
+		},
+	})
+}
@@ -4,11 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"log"
+	"regexp"
+	"slices"
+	"sort"
 	"strconv"
 
 	gofastly "github.com/fastly/go-fastly/v9/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/fastly/terraform-provider-fastly/fastly/hashcode"
 )
@@ -20,7 +24,7 @@ func dataSourceFastlyServices() *schema.Resource {
 			"details": {
 				Type:        schema.TypeSet,
 				Computed:    true,
-				Description: "A detailed list of Fastly services in your account. This is limited to the services the API token can read.",
+				Description: "A detailed list of the Fastly services in your account matching the filters. This is limited to the services the API token can read.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"comment": {
@@ -63,17 +67,95 @@ func dataSourceFastlyServices() *schema.Resource {
 							Computed:    true,
 							Description: "The currently activated version.",
 						},
+						"versions": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The versions of the service, sorted by number. Only set if `include_versions` is `true`.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"active": {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Whether this is the active version.",
+									},
+									"comment": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "A freeform descriptive note.",
+									},
+									"locked": {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Whether the version is locked, i.e. can no longer be modified.",
+									},
+									"number": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The version number.",
+									},
+									"staging": {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Whether the version is deployed to the staging environment.",
+									},
+									"updated_at": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Date and time in ISO 8601 format.",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return services with exactly this comment.",
+			},
+			"comment_regex": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only return services with a comment matching this regular expression.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsValidRegExp),
+			},
+			"has_active_version": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If `true`, only return services with an active version. If `false`, only return services without an active version. If not set, return both.",
+			},
 			"ids": {
 				Type:        schema.TypeSet,
 				Computed:    true,
-				Description: "A list of service IDs in your account. This is limited to the services the API token can read.",
+				Description: "A list of the IDs of the services in your account matching the filters. This is limited to the services the API token can read.",
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
+			"include_versions": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to list the versions of each service in `details`. This makes one API call per service. Default `false`",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the service with exactly this name.",
+			},
+			"name_regex": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only return services with a name matching this regular expression.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsValidRegExp),
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only return services of this type. One of `vcl`, `wasm`.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{ServiceTypeVCL, ServiceTypeCompute}, false)),
+			},
 		},
 	}
 }
@@ -88,11 +170,31 @@ func dataSourceFastlyServicesRead(_ context.Context, d *schema.ResourceData, met
 		return diag.Errorf("error fetching services: %s", err)
 	}
 
+	filters := expandServiceFilters(d)
+	remoteState = slices.DeleteFunc(remoteState, func(s *gofastly.Service) bool {
+		return !filters.matches(s)
+	})
+
+	var versions map[string][]*gofastly.Version
+	if d.Get("include_versions").(bool) {
+		versions = make(map[string][]*gofastly.Version, len(remoteState))
+		for _, s := range remoteState {
+			serviceID := gofastly.ToValue(s.ServiceID)
+			v, err := conn.ListVersions(&gofastly.ListVersionsInput{
+				ServiceID: serviceID,
+			})
+			if err != nil {
+				return diag.Errorf("error fetching versions of service (%s): %s", serviceID, err)
+			}
+			versions[serviceID] = v
+		}
+	}
+
 	hashBase, _ := json.Marshal(remoteState)
 	hashString := strconv.Itoa(hashcode.String(string(hashBase)))
 	d.SetId(hashString)
 
-	if err := d.Set("details", flattenServiceDetails(remoteState)); err != nil {
+	if err := d.Set("details", flattenServiceDetails(remoteState, versions)); err != nil {
 		return diag.Errorf("error setting services: %s", err)
 	}
 
@@ -103,6 +205,66 @@ func dataSourceFastlyServicesRead(_ context.Context, d *schema.ResourceData, met
 	return nil
 }
 
+// serviceFilters holds the filters of the services data source. Empty strings
+// and nil pointers mean the filter is not set.
+type serviceFilters struct {
+	Comment          string
+	CommentRegex     *regexp.Regexp
+	HasActiveVersion *bool
+	Name             string
+	NameRegex        *regexp.Regexp
+	Type             string
+}
+
+// expandServiceFilters reads the service filters from the configuration. The
+// raw configuration is used so that `has_active_version = false` can be told
+// apart from an unset filter. The regular expressions have been validated.
+func expandServiceFilters(d *schema.ResourceData) serviceFilters {
+	f := serviceFilters{
+		Comment: d.Get("comment").(string),
+		Name:    d.Get("name").(string),
+		Type:    d.Get("type").(string),
+	}
+
+	if v := d.Get("comment_regex").(string); v != "" {
+		f.CommentRegex = regexp.MustCompile(v)
+	}
+	if v := d.Get("name_regex").(string); v != "" {
+		f.NameRegex = regexp.MustCompile(v)
+	}
+	if v := d.GetRawConfig().GetAttr("has_active_version"); !v.IsNull() && v.IsKnown() {
+		f.HasActiveVersion = gofastly.ToPointer(v.True())
+	}
+
+	return f
+}
+
+// matches reports whether a service passes all the filters.
+func (f serviceFilters) matches(s *gofastly.Service) bool {
+	name := gofastly.ToValue(s.Name)
+	comment := gofastly.ToValue(s.Comment)
+
+	if f.Name != "" && name != f.Name {
+		return false
+	}
+	if f.NameRegex != nil && !f.NameRegex.MatchString(name) {
+		return false
+	}
+	if f.Comment != "" && comment != f.Comment {
+		return false
+	}
+	if f.CommentRegex != nil && !f.CommentRegex.MatchString(comment) {
+		return false
+	}
+	if f.Type != "" && gofastly.ToValue(s.Type) != f.Type {
+		return false
+	}
+	if f.HasActiveVersion != nil && *f.HasActiveVersion != (gofastly.ToValue(s.ActiveVersion) != 0) {
+		return false
+	}
+	return true
+}
+
 // flattenServiceIDs models data into format suitable for saving to Terraform state.
 func flattenServiceIDs(remoteState []*gofastly.Service) []string {
 	result := make([]string, len(remoteState))
@@ -115,7 +277,7 @@ func flattenServiceIDs(remoteState []*gofastly.Service) []string {
 }
 
 // flattenServiceDetails models data into format suitable for saving to Terraform state.
-func flattenServiceDetails(remoteState []*gofastly.Service) []map[string]any {
+func flattenServiceDetails(remoteState []*gofastly.Service, versions map[string][]*gofastly.Version) []map[string]any {
 	result := make([]map[string]any, len(remoteState))
 	if len(remoteState) == 0 {
 		return result
@@ -147,7 +309,32 @@ func flattenServiceDetails(remoteState []*gofastly.Service) []map[string]any {
 		if resource.ActiveVersion != nil {
 			result[i]["version"] = *resource.ActiveVersion
 		}
+		if v, ok := versions[gofastly.ToValue(resource.ServiceID)]; ok {
+			result[i]["versions"] = flattenServiceVersions(v)
+		}
 	}
 
 	return result
 }
+
+// flattenServiceVersions models data into format suitable for saving to Terraform state.
+func flattenServiceVersions(remoteState []*gofastly.Version) []map[string]any {
+	result := make([]map[string]any, 0, len(remoteState))
+	for _, resource := range remoteState {
+		if resource.DeletedAt != nil {
+			continue
+		}
+		result = append(result, map[string]any{
+			"active":     gofastly.ToValue(resource.Active),
+			"comment":    gofastly.ToValue(resource.Comment),
+			"locked":     gofastly.ToValue(resource.Locked),
+			"number":     gofastly.ToValue(resource.Number),
+			"staging":    gofastly.ToValue(resource.Staging),
+			"updated_at": formatRFC3339Time(resource.UpdatedAt),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i]["number"].(int) < result[j]["number"].(int)
+	})
+	return result
+}
@@ -4,11 +4,67 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"fmt"
+	"reflect"
+	"regexp"
 	"testing"
+	"time"
 
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
+func TestServiceFiltersMatches(t *testing.T) {
+	services := []*gofastly.Service{
+		{ServiceID: gofastly.ToPointer("1"), Name: gofastly.ToPointer("www-prod"), Comment: gofastly.ToPointer("team-a"), Type: gofastly.ToPointer(ServiceTypeVCL), ActiveVersion: gofastly.ToPointer(3)},
+		{ServiceID: gofastly.ToPointer("2"), Name: gofastly.ToPointer("www-staging"), Comment: gofastly.ToPointer("team-b"), Type: gofastly.ToPointer(ServiceTypeVCL), ActiveVersion: gofastly.ToPointer(0)},
+		{ServiceID: gofastly.ToPointer("3"), Name: gofastly.ToPointer("edge-prod"), Comment: gofastly.ToPointer("team-a"), Type: gofastly.ToPointer(ServiceTypeCompute), ActiveVersion: gofastly.ToPointer(1)},
+	}
+
+	for _, tc := range []struct {
+		name    string
+		filters serviceFilters
+		want    []string
+	}{
+		{name: "no filters", want: []string{"1", "2", "3"}},
+		{name: "name", filters: serviceFilters{Name: "www-prod"}, want: []string{"1"}},
+		{name: "name regex", filters: serviceFilters{NameRegex: regexp.MustCompile("-prod$")}, want: []string{"1", "3"}},
+		{name: "comment", filters: serviceFilters{Comment: "team-b"}, want: []string{"2"}},
+		{name: "comment regex", filters: serviceFilters{CommentRegex: regexp.MustCompile("^team-")}, want: []string{"1", "2", "3"}},
+		{name: "type", filters: serviceFilters{Type: ServiceTypeCompute}, want: []string{"3"}},
+		{name: "active version", filters: serviceFilters{HasActiveVersion: gofastly.ToPointer(true)}, want: []string{"1", "3"}},
+		{name: "no active version", filters: serviceFilters{HasActiveVersion: gofastly.ToPointer(false)}, want: []string{"2"}},
+		{name: "combined", filters: serviceFilters{NameRegex: regexp.MustCompile("^www-"), Comment: "team-a"}, want: []string{"1"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := []string{}
+			for _, s := range services {
+				if tc.filters.matches(s) {
+					got = append(got, *s.ServiceID)
+				}
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFlattenServiceVersions(t *testing.T) {
+	updated := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	got := flattenServiceVersions([]*gofastly.Version{
+		{Number: gofastly.ToPointer(2), Comment: gofastly.ToPointer("draft"), UpdatedAt: &updated},
+		{Number: gofastly.ToPointer(3), DeletedAt: &updated},
+		{Number: gofastly.ToPointer(1), Active: gofastly.ToPointer(true), Locked: gofastly.ToPointer(true)},
+	})
+	want := []map[string]any{
+		{"active": true, "comment": "", "locked": true, "number": 1, "staging": false, "updated_at": ""},
+		{"active": false, "comment": "draft", "locked": false, "number": 2, "staging": false, "updated_at": "2024-05-01T12:00:00Z"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
 func TestAccFastlyDataSourceServices_Config(t *testing.T) {
 	resourceName := "data.fastly_services.some"
 	serviceName := "fastly_service_vcl.example_service_for_data_sources"
@@ -28,6 +84,10 @@ func TestAccFastlyDataSourceServices_Config(t *testing.T) {
 						"comment": "example_comment",
 						"type":    "vcl",
 					}),
+					resource.TestCheckResourceAttr("data.fastly_services.filtered", "ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("data.fastly_services.filtered", "ids.*", serviceName, "id"),
+					resource.TestCheckResourceAttr("data.fastly_services.filtered", "details.0.versions.#", "1"),
+					resource.TestCheckResourceAttr("data.fastly_services.filtered", "details.0.versions.0.active", "true"),
 				),
 			},
 		},
@@ -50,6 +110,15 @@ resource "fastly_service_vcl" "example_service_for_data_sources" {
 data "fastly_services" "some" {
 	depends_on = [ fastly_service_vcl.example_service_for_data_sources ]
 }
+
+data "fastly_services" "filtered" {
+	name               = "example_service_for_data_sources"
+	type               = "vcl"
+	comment_regex      = "^example_"
+	has_active_version = true
+	include_versions   = true
+	depends_on         = [ fastly_service_vcl.example_service_for_data_sources ]
+}
 `
 
 	b := make([]byte, 16)
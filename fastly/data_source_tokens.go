@@ -0,0 +1,263 @@
+package fastly
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"slices"
+	"strconv"
+	"time"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/fastly/terraform-provider-fastly/fastly/hashcode"
+)
+
+func dataSourceFastlyTokens() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFastlyTokensRead,
+		Schema: tokenFilterSchema(map[string]*schema.Schema{
+			"customer_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Alphanumeric string identifying the customer whose tokens are listed. Defaults to the customer of the user authenticated by the provider.",
+			},
+			"tokens": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The API tokens matching the filters.",
+				Elem: &schema.Resource{
+					Schema: tokenDetailsSchema(nil),
+				},
+			},
+		}),
+	}
+}
+
+// tokenFilterSchema returns the filter and ID attributes shared by the token
+// data sources, merged with the data source specific attributes.
+func tokenFilterSchema(attributes map[string]*schema.Schema) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"expired": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "If `true`, only return expired tokens. If `false`, only return tokens that have not expired. If not set, return both.",
+		},
+		"ids": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The IDs of the tokens matching the filters.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"never_used": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "If `true`, only return tokens that have never been used. If `false`, only return tokens that have been used at least once. If not set, return both.",
+		},
+		"service_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Only return tokens that are explicitly restricted to the service with this ID. Tokens without service restrictions are not returned.",
+		},
+	}
+	for k, v := range attributes {
+		s[k] = v
+	}
+	return s
+}
+
+// tokenDetailsSchema returns the attributes describing a single token in the
+// token data sources, merged with the data source specific attributes.
+func tokenDetailsSchema(attributes map[string]*schema.Schema) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"created_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Date and time in ISO 8601 format.",
+		},
+		"expired": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the token has expired.",
+		},
+		"expires_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Date and time in ISO 8601 format when the token expires. Empty if the token does not expire.",
+		},
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Alphanumeric string identifying the token.",
+		},
+		"ip": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The IP address of the client that last used the token.",
+		},
+		"last_used_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Date and time in ISO 8601 format when the token was last used. Empty if the token has never been used.",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The name of the token.",
+		},
+		"scopes": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The authorization scopes of the token.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"services": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The IDs of the services the token is restricted to. Empty if the token has access to all services.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"user_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the user who created the token.",
+		},
+	}
+	for k, v := range attributes {
+		s[k] = v
+	}
+	return s
+}
+
+func dataSourceFastlyTokensRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	customerID := d.Get("customer_id").(string)
+	if customerID == "" {
+		user, err := conn.GetCurrentUser()
+		if err != nil {
+			return diag.Errorf("error fetching current user: %s", err)
+		}
+		customerID = gofastly.ToValue(user.CustomerID)
+	}
+
+	log.Printf("[DEBUG] Reading tokens for customer (%s)", customerID)
+
+	remoteState, err := conn.ListCustomerTokens(&gofastly.ListCustomerTokensInput{
+		CustomerID: customerID,
+	})
+	if err != nil {
+		return diag.Errorf("error fetching tokens: %s", err)
+	}
+
+	filters := expandTokenFilters(d)
+	now := time.Now()
+
+	var (
+		ids    []string
+		tokens []map[string]any
+	)
+	for _, t := range remoteState {
+		attrs := tokenAttributes{
+			CreatedAt:  t.CreatedAt,
+			ExpiresAt:  t.ExpiresAt,
+			LastUsedAt: t.LastUsedAt,
+			Name:       t.Name,
+			Scope:      t.Scope,
+			Services:   t.Services,
+			UserID:     t.UserID,
+		}
+		if !filters.matches(attrs, now) {
+			continue
+		}
+		token := flattenTokenDetails(attrs, now)
+		token["id"] = gofastly.ToValue(t.TokenID)
+		token["ip"] = gofastly.ToValue(t.IP)
+		ids = append(ids, gofastly.ToValue(t.TokenID))
+		tokens = append(tokens, token)
+	}
+
+	hashBase, _ := json.Marshal(ids)
+	hashString := strconv.Itoa(hashcode.String(customerID + string(hashBase)))
+	d.SetId(hashString)
+
+	if err := d.Set("customer_id", customerID); err != nil {
+		return diag.Errorf("error setting customer ID: %s", err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return diag.Errorf("error setting token IDs: %s", err)
+	}
+	if err := d.Set("tokens", tokens); err != nil {
+		return diag.Errorf("error setting tokens: %s", err)
+	}
+
+	return nil
+}
+
+// tokenFilters holds the audit filters of the token data sources. A nil
+// pointer means the filter is not set.
+type tokenFilters struct {
+	Expired   *bool
+	NeverUsed *bool
+	ServiceID string
+}
+
+// expandTokenFilters reads the token filters from the configuration. The raw
+// configuration is used so that a filter set to `false` can be told apart from
+// an unset filter.
+func expandTokenFilters(d *schema.ResourceData) tokenFilters {
+	var f tokenFilters
+
+	config := d.GetRawConfig()
+	if v := config.GetAttr("expired"); !v.IsNull() && v.IsKnown() {
+		f.Expired = gofastly.ToPointer(v.True())
+	}
+	if v := config.GetAttr("never_used"); !v.IsNull() && v.IsKnown() {
+		f.NeverUsed = gofastly.ToPointer(v.True())
+	}
+	f.ServiceID = d.Get("service_id").(string)
+
+	return f
+}
+
+// matches reports whether a token passes all the filters at `now`.
+func (f tokenFilters) matches(t tokenAttributes, now time.Time) bool {
+	if f.Expired != nil && *f.Expired != tokenExpired(t, now) {
+		return false
+	}
+	if f.NeverUsed != nil && *f.NeverUsed != (t.LastUsedAt == nil) {
+		return false
+	}
+	if f.ServiceID != "" && !slices.Contains(t.Services, f.ServiceID) {
+		return false
+	}
+	return true
+}
+
+func tokenExpired(t tokenAttributes, now time.Time) bool {
+	return t.ExpiresAt != nil && !t.ExpiresAt.After(now)
+}
+
+// flattenTokenDetails models the shared token attributes into a format
+// suitable for saving to Terraform state.
+func flattenTokenDetails(t tokenAttributes, now time.Time) map[string]any {
+	return map[string]any{
+		"created_at":   formatRFC3339Time(t.CreatedAt),
+		"expired":      tokenExpired(t, now),
+		"expires_at":   formatRFC3339Time(t.ExpiresAt),
+		"last_used_at": formatRFC3339Time(t.LastUsedAt),
+		"name":         gofastly.ToValue(t.Name),
+		"scopes":       flattenTokenScope(t.Scope),
+		"services":     t.Services,
+		"user_id":      gofastly.ToValue(t.UserID),
+	}
+}
@@ -0,0 +1,89 @@
+package fastly
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestTokenFiltersMatches(t *testing.T) {
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	expiredUnused := tokenAttributes{ExpiresAt: &past}
+	activeUsed := tokenAttributes{ExpiresAt: &future, LastUsedAt: &past, Services: []string{"svc1"}}
+	neverExpires := tokenAttributes{Services: []string{"svc2"}}
+
+	for name, testCase := range map[string]struct {
+		filters  tokenFilters
+		token    tokenAttributes
+		expected bool
+	}{
+		"no filters":                  {tokenFilters{}, expiredUnused, true},
+		"expired matches":             {tokenFilters{Expired: gofastly.ToPointer(true)}, expiredUnused, true},
+		"expired excludes active":     {tokenFilters{Expired: gofastly.ToPointer(true)}, activeUsed, false},
+		"unexpired matches no expiry": {tokenFilters{Expired: gofastly.ToPointer(false)}, neverExpires, true},
+		"never used matches":          {tokenFilters{NeverUsed: gofastly.ToPointer(true)}, expiredUnused, true},
+		"never used excludes used":    {tokenFilters{NeverUsed: gofastly.ToPointer(true)}, activeUsed, false},
+		"used matches":                {tokenFilters{NeverUsed: gofastly.ToPointer(false)}, activeUsed, true},
+		"service matches":             {tokenFilters{ServiceID: "svc1"}, activeUsed, true},
+		"service excludes other":      {tokenFilters{ServiceID: "svc1"}, neverExpires, false},
+		"service excludes global":     {tokenFilters{ServiceID: "svc1"}, expiredUnused, false},
+		"combined": {
+			tokenFilters{Expired: gofastly.ToPointer(false), NeverUsed: gofastly.ToPointer(false), ServiceID: "svc1"},
+			activeUsed,
+			true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if actual := testCase.filters.matches(testCase.token, now); actual != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestAccFastlyDataSourceTokens_Config(t *testing.T) {
+	name := acctest.RandomWithPrefix(testResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckAutomationTokenDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFastlyDataSourceTokensConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair("data.fastly_automation_tokens.unused", "ids.*", "fastly_automation_token.example", "id"),
+					resource.TestCheckResourceAttrSet("data.fastly_tokens.all", "customer_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFastlyDataSourceTokensConfig(name string) string {
+	return fmt.Sprintf(`
+resource "fastly_automation_token" "example" {
+  name = "%s"
+  role = "user"
+  ttl  = "24h"
+}
+
+data "fastly_automation_tokens" "unused" {
+  never_used = true
+  expired    = false
+  role       = "user"
+  depends_on = [fastly_automation_token.example]
+}
+
+data "fastly_tokens" "all" {}
+`, name)
+}
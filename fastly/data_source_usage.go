@@ -0,0 +1,261 @@
+package fastly
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strconv"
+	"time"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/fastly/terraform-provider-fastly/fastly/hashcode"
+)
+
+func dataSourceFastlyUsage() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFastlyUsageRead,
+		Schema: map[string]*schema.Schema{
+			"bandwidth": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total bandwidth across all the regions, in bytes.",
+			},
+			"compute_requests": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of Compute requests across all the regions.",
+			},
+			"from": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The start of the time range, inclusive. Either a date and time in RFC 3339 format, a Unix timestamp or a relative time such as `1 month ago`. Defaults to the API default.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only report the usage in this region, e.g. `usa` or `europe`.",
+			},
+			"regions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The usage across all the services, per region, sorted by region.",
+				Elem: &schema.Resource{
+					Schema: usageSchema(),
+				},
+			},
+			"requests": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of requests across all the regions.",
+			},
+			"service_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Only report the usage of these services in `services`. The region totals always cover all the services.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"services": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The usage per service, sorted by service ID.",
+				Elem: &schema.Resource{
+					Schema: usageByServiceSchema(),
+				},
+			},
+			"to": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The end of the time range, exclusive. Either a date and time in RFC 3339 format, a Unix timestamp or a relative time such as `now`. Defaults to the API default.",
+			},
+		},
+	}
+}
+
+// usageSchema returns the attributes describing the usage in a single region.
+func usageSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"bandwidth": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The bandwidth, in bytes.",
+		},
+		"compute_requests": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of Compute requests.",
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The name of the region.",
+		},
+		"requests": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of requests.",
+		},
+	}
+}
+
+// usageByServiceSchema returns the attributes describing the usage of a single
+// service.
+func usageByServiceSchema() map[string]*schema.Schema {
+	s := usageSchema()
+	delete(s, "region")
+	s["regions"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The usage of the service per region, sorted by region.",
+		Elem: &schema.Resource{
+			Schema: usageSchema(),
+		},
+	}
+	s["service_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Alphanumeric string identifying the service.",
+	}
+	return s
+}
+
+func dataSourceFastlyUsageRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	log.Printf("[DEBUG] Reading usage")
+
+	input := &gofastly.GetUsageInput{}
+	if v := d.Get("from").(string); v != "" {
+		input.From = gofastly.ToPointer(expandStatsTime(v))
+	}
+	if v := d.Get("to").(string); v != "" {
+		input.To = gofastly.ToPointer(expandStatsTime(v))
+	}
+	if v := d.Get("region").(string); v != "" {
+		input.Region = gofastly.ToPointer(v)
+	}
+
+	usage, err := conn.GetUsage(input)
+	if err != nil {
+		return diag.Errorf("error fetching usage: %s", err)
+	}
+	usageByService, err := conn.GetUsageByService(input)
+	if err != nil {
+		return diag.Errorf("error fetching usage by service: %s", err)
+	}
+
+	var regions gofastly.RegionsUsage
+	if usage.Data != nil {
+		regions = *usage.Data
+	}
+	var services gofastly.ServicesByRegionsUsage
+	if usageByService.Data != nil {
+		services = *usageByService.Data
+	}
+
+	flattenedRegions := flattenUsageRegions(regions)
+	flattenedServices := flattenUsageByService(services, buildStringSlice(d.Get("service_ids").(*schema.Set)))
+
+	hashBase, _ := json.Marshal([]any{input, flattenedRegions, flattenedServices})
+	d.SetId(strconv.Itoa(hashcode.String(string(hashBase))))
+
+	totals := sumUsage(flattenedRegions)
+	for _, key := range sortedKeys(totals) {
+		if err := d.Set(key, totals[key]); err != nil {
+			return diag.Errorf("error setting %s: %s", key, err)
+		}
+	}
+	if err := d.Set("regions", flattenedRegions); err != nil {
+		return diag.Errorf("error setting regions: %s", err)
+	}
+	if err := d.Set("services", flattenedServices); err != nil {
+		return diag.Errorf("error setting services: %s", err)
+	}
+
+	return nil
+}
+
+// expandStatsTime converts a date and time in RFC 3339 format to the Unix
+// timestamp expected by the stats API. Other values, i.e. Unix timestamps and
+// relative times, are passed through as is.
+func expandStatsTime(v string) string {
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return strconv.FormatInt(t.Unix(), 10)
+	}
+	return v
+}
+
+// flattenUsageRegions models data into format suitable for saving to Terraform state.
+func flattenUsageRegions(remoteState gofastly.RegionsUsage) []map[string]any {
+	result := make([]map[string]any, 0, len(remoteState))
+	for _, region := range sortedKeys(remoteState) {
+		result = append(result, flattenUsage(region, remoteState[region]))
+	}
+	return result
+}
+
+// flattenUsageByService models data into format suitable for saving to
+// Terraform state. The API groups the usage by region then by service, while
+// it is grouped by service then by region here. If serviceIDs is not empty,
+// only these services are kept.
+func flattenUsageByService(remoteState gofastly.ServicesByRegionsUsage, serviceIDs []string) []map[string]any {
+	keep := make(map[string]bool, len(serviceIDs))
+	for _, id := range serviceIDs {
+		keep[id] = true
+	}
+
+	byService := map[string]gofastly.RegionsUsage{}
+	for region, services := range remoteState {
+		if services == nil {
+			continue
+		}
+		for serviceID, usage := range *services {
+			if len(keep) > 0 && !keep[serviceID] {
+				continue
+			}
+			if byService[serviceID] == nil {
+				byService[serviceID] = gofastly.RegionsUsage{}
+			}
+			byService[serviceID][region] = usage
+		}
+	}
+
+	result := make([]map[string]any, 0, len(byService))
+	for _, serviceID := range sortedKeys(byService) {
+		regions := flattenUsageRegions(byService[serviceID])
+		service := sumUsage(regions)
+		service["regions"] = regions
+		service["service_id"] = serviceID
+		result = append(result, service)
+	}
+	return result
+}
+
+func flattenUsage(region string, u *gofastly.Usage) map[string]any {
+	if u == nil {
+		u = &gofastly.Usage{}
+	}
+	return map[string]any{
+		"bandwidth":        int(gofastly.ToValue(u.Bandwidth)),
+		"compute_requests": int(gofastly.ToValue(u.ComputeRequests)),
+		"region":           region,
+		"requests":         int(gofastly.ToValue(u.Requests)),
+	}
+}
+
+// sumUsage adds up the usage of flattened regions.
+func sumUsage(regions []map[string]any) map[string]any {
+	var bandwidth, computeRequests, requests int
+	for _, r := range regions {
+		bandwidth += r["bandwidth"].(int)
+		computeRequests += r["compute_requests"].(int)
+		requests += r["requests"].(int)
+	}
+	return map[string]any{
+		"bandwidth":        bandwidth,
+		"compute_requests": computeRequests,
+		"requests":         requests,
+	}
+}
@@ -0,0 +1,116 @@
+package fastly
+
+import (
+	"reflect"
+	"testing"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestExpandStatsTime(t *testing.T) {
+	for _, tc := range []struct {
+		value string
+		want  string
+	}{
+		{value: "2024-05-01T00:00:00Z", want: "1714521600"},
+		{value: "2024-05-01T02:00:00+02:00", want: "1714521600"},
+		{value: "1714521600", want: "1714521600"},
+		{value: "1 month ago", want: "1 month ago"},
+	} {
+		if got := expandStatsTime(tc.value); got != tc.want {
+			t.Errorf("expandStatsTime(%q) = %q, want %q", tc.value, got, tc.want)
+		}
+	}
+}
+
+func TestFlattenUsageByService(t *testing.T) {
+	usage := func(bandwidth, requests, computeRequests uint64) *gofastly.Usage {
+		return &gofastly.Usage{
+			Bandwidth:       gofastly.ToPointer(bandwidth),
+			ComputeRequests: gofastly.ToPointer(computeRequests),
+			Requests:        gofastly.ToPointer(requests),
+		}
+	}
+	remote := gofastly.ServicesByRegionsUsage{
+		"usa": &gofastly.ServicesUsage{
+			"b": usage(100, 10, 0),
+			"a": usage(200, 20, 5),
+		},
+		"europe": &gofastly.ServicesUsage{
+			"a": usage(50, 5, 1),
+		},
+	}
+
+	want := []map[string]any{
+		{
+			"bandwidth":        250,
+			"compute_requests": 6,
+			"requests":         25,
+			"service_id":       "a",
+			"regions": []map[string]any{
+				{"bandwidth": 50, "compute_requests": 1, "region": "europe", "requests": 5},
+				{"bandwidth": 200, "compute_requests": 5, "region": "usa", "requests": 20},
+			},
+		},
+		{
+			"bandwidth":        100,
+			"compute_requests": 0,
+			"requests":         10,
+			"service_id":       "b",
+			"regions": []map[string]any{
+				{"bandwidth": 100, "compute_requests": 0, "region": "usa", "requests": 10},
+			},
+		},
+	}
+	if got := flattenUsageByService(remote, nil); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+	if got := flattenUsageByService(remote, []string{"b"}); !reflect.DeepEqual(got, want[1:]) {
+		t.Errorf("got %#v, want %#v", got, want[1:])
+	}
+}
+
+func TestFlattenUsageRegions(t *testing.T) {
+	remote := gofastly.RegionsUsage{
+		"usa":    {Bandwidth: gofastly.ToPointer(uint64(100)), Requests: gofastly.ToPointer(uint64(10))},
+		"europe": nil,
+	}
+	want := []map[string]any{
+		{"bandwidth": 0, "compute_requests": 0, "region": "europe", "requests": 0},
+		{"bandwidth": 100, "compute_requests": 0, "region": "usa", "requests": 10},
+	}
+	got := flattenUsageRegions(remote)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+	if totals := sumUsage(got); !reflect.DeepEqual(totals, map[string]any{"bandwidth": 100, "compute_requests": 0, "requests": 10}) {
+		t.Errorf("unexpected totals %#v", totals)
+	}
+}
+
+func TestAccFastlyDataSourceUsage_Config(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "fastly_usage" "example" {
+  from = "1 week ago"
+  to   = "now"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.fastly_usage.example", "bandwidth"),
+					resource.TestCheckResourceAttrSet("data.fastly_usage.example", "requests"),
+					resource.TestCheckResourceAttrSet("data.fastly_usage.example", "compute_requests"),
+					resource.TestCheckResourceAttrSet("data.fastly_usage.example", "regions.#"),
+					resource.TestCheckResourceAttrSet("data.fastly_usage.example", "services.#"),
+				),
+			},
+		},
+	})
+}
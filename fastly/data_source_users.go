@@ -0,0 +1,189 @@
+package fastly
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFastlyUsers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFastlyUsersRead,
+		Schema: map[string]*schema.Schema{
+			"customer_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Alphanumeric string identifying the customer whose users are listed. Defaults to the customer of the user authenticated by the provider.",
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IDs of the users matching the filters, sorted by login.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"role": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only return users with this role. Can be `user`, `billing`, `engineer`, or `superuser`.",
+				ValidateDiagFunc: validateUserRole(),
+			},
+			"users": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The users matching the filters, sorted by login.",
+				Elem: &schema.Resource{
+					Schema: userDetailsSchema(),
+				},
+			},
+		},
+	}
+}
+
+// userDetailsSchema returns the attributes describing a single user in the
+// user data sources.
+func userDetailsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"created_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Date and time in ISO 8601 format.",
+		},
+		"customer_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Alphanumeric string identifying the customer.",
+		},
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Alphanumeric string identifying the user.",
+		},
+		"limit_services": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the user can only access the services they are explicitly granted access to.",
+		},
+		"locked": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the user is locked out of the account.",
+		},
+		"login": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The email address, which is the login name, of the user.",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The real life name of the user.",
+		},
+		"role": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The role of the user. One of `user`, `billing`, `engineer`, `superuser`.",
+		},
+		"two_factor_auth_enabled": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the user has two-factor authentication enabled.",
+		},
+		"updated_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Date and time in ISO 8601 format.",
+		},
+	}
+}
+
+func dataSourceFastlyUsersRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	customerID := d.Get("customer_id").(string)
+	if customerID == "" {
+		user, err := conn.GetCurrentUser()
+		if err != nil {
+			return diag.Errorf("error fetching current user: %s", err)
+		}
+		customerID = gofastly.ToValue(user.CustomerID)
+	}
+
+	log.Printf("[DEBUG] Reading users for customer (%s)", customerID)
+
+	remoteState, err := conn.ListCustomerUsers(&gofastly.ListCustomerUsersInput{
+		CustomerID: customerID,
+	})
+	if err != nil {
+		return diag.Errorf("error fetching users: %s", err)
+	}
+
+	users := filterUsers(remoteState, d.Get("role").(string))
+
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, gofastly.ToValue(u.UserID))
+	}
+
+	d.SetId(customerID)
+	if err := d.Set("customer_id", customerID); err != nil {
+		return diag.Errorf("error setting customer_id: %s", err)
+	}
+	if err := d.Set("users", flattenUsers(users)); err != nil {
+		return diag.Errorf("error setting users: %s", err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return diag.Errorf("error setting user IDs: %s", err)
+	}
+
+	return nil
+}
+
+// filterUsers returns the users that have not been deleted, with the given
+// role if it is set, sorted by login.
+func filterUsers(users []*gofastly.User, role string) []*gofastly.User {
+	result := []*gofastly.User{}
+	for _, u := range users {
+		if u.DeletedAt != nil {
+			continue
+		}
+		if role != "" && gofastly.ToValue(u.Role) != role {
+			continue
+		}
+		result = append(result, u)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return gofastly.ToValue(result[i].Login) < gofastly.ToValue(result[j].Login)
+	})
+	return result
+}
+
+// flattenUsers models data into format suitable for saving to Terraform state.
+func flattenUsers(remoteState []*gofastly.User) []map[string]any {
+	result := make([]map[string]any, 0, len(remoteState))
+	for _, u := range remoteState {
+		result = append(result, flattenUser(u))
+	}
+	return result
+}
+
+func flattenUser(u *gofastly.User) map[string]any {
+	return map[string]any{
+		"created_at":              formatRFC3339Time(u.CreatedAt),
+		"customer_id":             gofastly.ToValue(u.CustomerID),
+		"id":                      gofastly.ToValue(u.UserID),
+		"limit_services":          gofastly.ToValue(u.LimitServices),
+		"locked":                  gofastly.ToValue(u.Locked),
+		"login":                   gofastly.ToValue(u.Login),
+		"name":                    gofastly.ToValue(u.Name),
+		"role":                    gofastly.ToValue(u.Role),
+		"two_factor_auth_enabled": gofastly.ToValue(u.TwoFactorAuthEnabled),
+		"updated_at":              formatRFC3339Time(u.UpdatedAt),
+	}
+}
@@ -0,0 +1,79 @@
+package fastly
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestFilterUsers(t *testing.T) {
+	deleted := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	users := []*gofastly.User{
+		{UserID: gofastly.ToPointer("3"), Login: gofastly.ToPointer("c@example.com"), Role: gofastly.ToPointer("engineer")},
+		{UserID: gofastly.ToPointer("1"), Login: gofastly.ToPointer("a@example.com"), Role: gofastly.ToPointer("superuser")},
+		{UserID: gofastly.ToPointer("2"), Login: gofastly.ToPointer("b@example.com"), Role: gofastly.ToPointer("engineer")},
+		{UserID: gofastly.ToPointer("4"), Login: gofastly.ToPointer("d@example.com"), Role: gofastly.ToPointer("engineer"), DeletedAt: &deleted},
+	}
+
+	for _, tc := range []struct {
+		role string
+		want []string
+	}{
+		{role: "", want: []string{"1", "2", "3"}},
+		{role: "engineer", want: []string{"2", "3"}},
+		{role: "billing", want: []string{}},
+	} {
+		t.Run(tc.role, func(t *testing.T) {
+			got := []string{}
+			for _, u := range filterUsers(users, tc.role) {
+				got = append(got, *u.UserID)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAccFastlyDataSourceUsers_Config(t *testing.T) {
+	login := fmt.Sprintf("tf-test-%s@example.com", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "fastly_user" "example" {
+  login = "%s"
+  name  = "tf-test"
+  role  = "billing"
+}
+
+data "fastly_current_user" "me" {}
+
+data "fastly_users" "billing" {
+  role       = "billing"
+  depends_on = [fastly_user.example]
+}
+`, login),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.fastly_current_user.me", "login"),
+					resource.TestCheckResourceAttrPair("data.fastly_users.billing", "customer_id", "data.fastly_current_user.me", "customer_id"),
+					resource.TestCheckTypeSetElemAttrPair("data.fastly_users.billing", "ids.*", "fastly_user.example", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.fastly_users.billing", "users.*", map[string]string{
+						"login": login,
+						"role":  "billing",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,228 @@
+package fastly
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"slices"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// datacentersJSON is a snapshot of the `GET /datacenters` API response, used
+// when the API cannot be reached. Refresh it with `make update-datacenters`.
+//
+//go:embed datacenters.json
+var datacentersJSON []byte
+
+// datacentersSnapshot is the parsed datacenters snapshot.
+var datacentersSnapshot = mustParseDatacenters(datacentersJSON)
+
+// maxShieldTypoDistance is the largest edit distance between an unknown shield
+// and a shield of the snapshot for the former to be reported as a typo.
+const maxShieldTypoDistance = 2
+
+type datacenterSnapshotEntry struct {
+	Code        string `json:"code"`
+	Coordinates struct {
+		Latitude  float64 `json:"latitude"`
+		Longitude float64 `json:"longitude"`
+	} `json:"coordinates"`
+	Group  string `json:"group"`
+	Name   string `json:"name"`
+	Shield string `json:"shield"`
+}
+
+func mustParseDatacenters(data []byte) []gofastly.Datacenter {
+	var entries []datacenterSnapshotEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		panic(fmt.Sprintf("invalid datacenters snapshot: %s", err))
+	}
+
+	result := make([]gofastly.Datacenter, 0, len(entries))
+	for _, e := range entries {
+		dc := gofastly.Datacenter{
+			Code: gofastly.ToPointer(e.Code),
+			Coordinates: &gofastly.Coordinates{
+				Latitude:   gofastly.ToPointer(e.Coordinates.Latitude),
+				Longtitude: gofastly.ToPointer(e.Coordinates.Longitude),
+			},
+			Group: gofastly.ToPointer(e.Group),
+			Name:  gofastly.ToPointer(e.Name),
+		}
+		if e.Shield != "" {
+			dc.Shield = gofastly.ToPointer(e.Shield)
+		}
+		result = append(result, dc)
+	}
+	return result
+}
+
+// getDatacenters returns the datacenters from the API, fetched once per
+// provider instance. The snapshot is returned instead, with live set to
+// false, when the provider has no API key or the API cannot be reached.
+func (c *APIClient) getDatacenters() (datacenters []gofastly.Datacenter, live bool) {
+	if c == nil || c.noAuth {
+		return datacentersSnapshot, false
+	}
+
+	c.datacentersOnce.Do(func() {
+		c.datacenters, c.datacentersErr = c.conn.AllDatacenters()
+	})
+	if c.datacentersErr != nil {
+		log.Printf("[WARN] Error fetching datacenters, using the embedded snapshot: %s", c.datacentersErr)
+		return datacentersSnapshot, false
+	}
+	return c.datacenters, true
+}
+
+// shieldCodes returns the sorted shield codes of the datacenters.
+func shieldCodes(datacenters []gofastly.Datacenter) []string {
+	var result []string
+	for _, dc := range datacenters {
+		if s := gofastly.ToValue(dc.Shield); s != "" && !slices.Contains(result, s) {
+			result = append(result, s)
+		}
+	}
+	slices.Sort(result)
+	return result
+}
+
+// checkShield reports whether shield is a valid shield code, suggesting the
+// nearest one otherwise. Against a snapshot, which may lag behind the API, only
+// likely typos are reported: unknown codes far from all the known ones may be
+// new POPs.
+func checkShield(shield string, codes []string, live bool) error {
+	if shield == "" || slices.Contains(codes, shield) {
+		return nil
+	}
+
+	nearest, distance := nearestString(shield, codes)
+	if !live && distance > maxShieldTypoDistance {
+		log.Printf("[WARN] Shield %q is not in the datacenters snapshot, it may be a new POP", shield)
+		return nil
+	}
+	if nearest == "" {
+		return fmt.Errorf("%q is not a valid shield POP", shield)
+	}
+	return fmt.Errorf("%q is not a valid shield POP, did you mean %q?", shield, nearest)
+}
+
+// nearestString returns the candidate with the smallest edit distance to s,
+// and that distance. The first candidate wins ties.
+func nearestString(s string, candidates []string) (string, int) {
+	nearest, best := "", math.MaxInt
+	for _, c := range candidates {
+		if d := levenshtein(s, c); d < best {
+			nearest, best = c, d
+		}
+	}
+	return nearest, best
+}
+
+// levenshtein returns the edit distance between two strings.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+// validateShields is a CustomizeDiff function rejecting `shield` values of the
+// backends and directors that are not valid shield POPs. Only new or changed
+// values are rejected: a POP retired since it was set is logged, so that the
+// plans of services not touching it (or moving off it) still succeed. Values
+// not known yet are skipped.
+func validateShields(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	client, _ := meta.(*APIClient)
+
+	var codes []string
+	var live bool
+	loaded := false
+
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+	for _, block := range []string{"backend", "director"} {
+		shields := blockShields(config, block)
+		prior := blockShields(d.GetRawState(), block)
+		for _, name := range sortedKeys(shields) {
+			shield := shields[name]
+			if !loaded {
+				var datacenters []gofastly.Datacenter
+				datacenters, live = client.getDatacenters()
+				codes = shieldCodes(datacenters)
+				loaded = true
+			}
+			if err := checkShield(shield, codes, live); err != nil {
+				if prior[name] == shield {
+					log.Printf("[WARN] %s %q: shield %s", block, name, err)
+					continue
+				}
+				return fmt.Errorf("%s %q: shield %w", block, name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// blockShields returns the known, non-empty `shield` values of the blocks of
+// a config or state, by block name.
+func blockShields(v cty.Value, block string) map[string]string {
+	result := make(map[string]string)
+	if v.IsNull() || !v.IsKnown() || !v.Type().HasAttribute(block) {
+		return result
+	}
+	blocks := v.GetAttr(block)
+	if blocks.IsNull() || !blocks.IsKnown() {
+		return result
+	}
+	for it := blocks.ElementIterator(); it.Next(); {
+		_, b := it.Element()
+		if shield := knownString(b.GetAttr("shield")); shield != "" {
+			result[knownString(b.GetAttr("name"))] = shield
+		}
+	}
+	return result
+}
+
+// knownString returns the value of a string, or "" if it is null or unknown.
+func knownString(v cty.Value) string {
+	if v.IsNull() || !v.IsKnown() {
+		return ""
+	}
+	return v.AsString()
+}
+
+// greatCircleDistance returns the distance in kilometers between two points,
+// given in degrees, using the haversine formula.
+func greatCircleDistance(lat1, lon1, lat2, lon2 float64) float64 {
+	const earthRadius = 6371.0
+	toRadians := func(deg float64) float64 { return deg * math.Pi / 180 }
+
+	dLat := toRadians(lat2 - lat1)
+	dLon := toRadians(lon2 - lon1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRadians(lat1))*math.Cos(toRadians(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(a))
+}
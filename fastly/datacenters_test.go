@@ -0,0 +1,154 @@
+package fastly
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"testing"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDatacentersSnapshot(t *testing.T) {
+	if len(datacentersSnapshot) == 0 {
+		t.Fatal("the datacenters snapshot is empty")
+	}
+	codes := map[string]bool{}
+	for _, dc := range datacentersSnapshot {
+		code := gofastly.ToValue(dc.Code)
+		if code == "" || codes[code] {
+			t.Errorf("missing or duplicate code %q", code)
+		}
+		codes[code] = true
+		if dc.Coordinates == nil || dc.Coordinates.Latitude == nil || dc.Coordinates.Longtitude == nil {
+			t.Errorf("%s: missing coordinates", code)
+		}
+	}
+	if len(shieldCodes(datacentersSnapshot)) == 0 {
+		t.Error("the datacenters snapshot has no shield POPs")
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	for _, tc := range []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"", "abc", 3},
+		{"amsterdam-nl", "amsterdam-nl", 0},
+		{"amsterdam-nI", "amsterdam-nl", 1},
+		{"amsterdamnl", "amsterdam-nl", 1},
+		{"kitten", "sitting", 3},
+	} {
+		if got := levenshtein(tc.a, tc.b); got != tc.want {
+			t.Errorf("levenshtein(%q, %q) = %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestCheckShield(t *testing.T) {
+	codes := []string{"amsterdam-nl", "iad-va-us", "lga-ny-us"}
+
+	for _, tc := range []struct {
+		name    string
+		shield  string
+		live    bool
+		wantErr string
+	}{
+		{name: "empty", shield: "", live: true},
+		{name: "valid", shield: "iad-va-us", live: true},
+		{name: "live typo", shield: "amsterdam-nI", live: true, wantErr: `"amsterdam-nI" is not a valid shield POP, did you mean "amsterdam-nl"?`},
+		{name: "live unknown", shield: "new-pop-xx", live: true, wantErr: `"new-pop-xx" is not a valid shield POP, did you mean`},
+		{name: "snapshot typo", shield: "lga-ny-su", live: false, wantErr: `did you mean "lga-ny-us"?`},
+		{name: "snapshot unknown", shield: "new-pop-xx", live: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkShield(tc.shield, codes, tc.live)
+			switch {
+			case tc.wantErr == "" && err != nil:
+				t.Errorf("unexpected error: %s", err)
+			case tc.wantErr != "" && err == nil:
+				t.Errorf("expected an error matching %q", tc.wantErr)
+			case tc.wantErr != "" && !regexp.MustCompile(regexp.QuoteMeta(tc.wantErr)).MatchString(err.Error()):
+				t.Errorf("got error %q, want %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestBlockShields(t *testing.T) {
+	backend := func(name string, shield cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal(name), "shield": shield})
+	}
+	v := cty.ObjectVal(map[string]cty.Value{
+		"backend": cty.SetVal([]cty.Value{
+			backend("a", cty.StringVal("iad-va-us")),
+			backend("b", cty.StringVal("")),
+			backend("c", cty.NullVal(cty.String)),
+			backend("d", cty.UnknownVal(cty.String)),
+		}),
+	})
+
+	if got, want := blockShields(v, "backend"), map[string]string{"a": "iad-va-us"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("want %#v, got %#v", want, got)
+	}
+	if got := blockShields(v, "director"); len(got) != 0 {
+		t.Errorf("unexpected shields %#v", got)
+	}
+	if got := blockShields(cty.NullVal(v.Type()), "backend"); len(got) != 0 {
+		t.Errorf("unexpected shields %#v", got)
+	}
+}
+
+func TestGreatCircleDistance(t *testing.T) {
+	// Amsterdam to New York is about 5,860 km.
+	got := greatCircleDistance(52.308613, 4.763889, 40.776927, -73.873966)
+	if math.Abs(got-5860) > 30 {
+		t.Errorf("got %f km, want about 5860 km", got)
+	}
+	if got := greatCircleDistance(1, 2, 1, 2); got != 0 {
+		t.Errorf("got %f km between identical points, want 0", got)
+	}
+}
+
+func TestAccFastlyServiceVCL_invalidShield(t *testing.T) {
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domain := fmt.Sprintf("fastly-test.%s.com", name)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckServiceVCLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "fastly_service_vcl" "example" {
+  name = "%s"
+
+  domain {
+    name = "%s"
+  }
+
+  backend {
+    address = "httpbin.org"
+    name    = "httpbin"
+    shield  = "amsterdam-nI"
+  }
+
+  force_destroy = true
+}
+`, name, domain),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`backend "httpbin": shield "amsterdam-nI" is not a valid shield POP, did you mean "amsterdam-nl"\?`),
+			},
+		},
+	})
+}
@@ -44,13 +44,26 @@ func Provider() *schema.Provider {
 			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
+			"fastly_account_events":               dataSourceFastlyAccountEvents(),
+			"fastly_alert_definitions":            dataSourceFastlyAlertDefinitions(),
+			"fastly_automation_tokens":            dataSourceFastlyAutomationTokens(),
+			"fastly_billing":                      dataSourceFastlyBilling(),
+			"fastly_configstore_entries":          dataSourceFastlyConfigStoreEntries(),
+			"fastly_configstore_entry":            dataSourceFastlyConfigStoreEntry(),
 			"fastly_configstores":                 dataSourceFastlyConfigStores(),
+			"fastly_current_user":                 dataSourceFastlyCurrentUser(),
+			"fastly_custom_dashboard":             dataSourceFastlyCustomDashboard(),
 			"fastly_datacenters":                  dataSourceFastlyDatacenters(),
 			"fastly_dictionaries":                 dataSourceFastlyDictionaries(),
+			"fastly_domains_v1":                   dataSourceFastlyDomainsV1(),
 			"fastly_ip_ranges":                    dataSourceFastlyIPRanges(),
+			"fastly_kvstore_entry":                dataSourceFastlyKVStoreEntry(),
 			"fastly_kvstores":                     dataSourceFastlyKVStores(),
+			"fastly_package_archive":              dataSourceFastlyPackageArchive(),
 			"fastly_package_hash":                 dataSourceFastlyPackageHash(),
 			"fastly_secretstores":                 dataSourceFastlySecretStores(),
+			"fastly_service":                      dataSourceFastlyService(),
+			"fastly_service_stats":                dataSourceFastlyServiceStats(),
 			"fastly_services":                     dataSourceFastlyServices(),
 			"fastly_tls_activation":               dataSourceFastlyTLSActivation(),
 			"fastly_tls_activation_ids":           dataSourceFastlyTLSActivationIds(),
@@ -65,20 +78,30 @@ func Provider() *schema.Provider {
 			"fastly_tls_private_key_ids":          dataSourceFastlyTLSPrivateKeyIDs(),
 			"fastly_tls_subscription":             dataSourceFastlyTLSSubscription(),
 			"fastly_tls_subscription_ids":         dataSourceFastlyTLSSubscriptionIDs(),
+			"fastly_tokens":                       dataSourceFastlyTokens(),
+			"fastly_usage":                        dataSourceFastlyUsage(),
+			"fastly_users":                        dataSourceFastlyUsers(),
 			"fastly_vcl_snippets":                 dataSourceFastlyVCLSnippets(),
 			"fastly_waf_rules":                    dataSourceFastlyWAFRules(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"fastly_alert":                           resourceFastlyAlert(),
+			"fastly_alert_policy":                    resourceFastlyAlertPolicy(),
+			"fastly_api_token":                       resourceFastlyAPIToken(),
+			"fastly_automation_token":                resourceFastlyAutomationToken(),
 			"fastly_configstore":                     resourceFastlyConfigStore(),
 			"fastly_configstore_entries":             resourceFastlyConfigStoreEntries(),
 			"fastly_custom_dashboard":                resourceFastlyCustomDashboard(),
 			"fastly_domain_v1":                       resourceFastlyDomainV1(),
+			"fastly_domain_v1_service_link":          resourceFastlyDomainV1ServiceLink(),
 			"fastly_integration":                     resourceFastlyIntegration(),
+			"fastly_integration_webhook_signing_key": resourceFastlyIntegrationWebhookSigningKey(),
 			"fastly_kvstore":                         resourceFastlyKVStore(),
+			"fastly_purge":                           resourceFastlyPurge(),
 			"fastly_secretstore":                     resourceFastlySecretStore(),
 			"fastly_service_acl_entries":             resourceServiceACLEntries(),
 			"fastly_service_authorization":           resourceServiceAuthorization(),
+			"fastly_service_authorizations":          resourceServiceAuthorizations(),
 			"fastly_service_compute":                 resourceServiceCompute(),
 			"fastly_service_dictionary_items":        resourceServiceDictionaryItems(),
 			"fastly_service_dynamic_snippet_content": resourceServiceDynamicSnippetContent(),
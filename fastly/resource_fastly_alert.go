@@ -3,11 +3,13 @@ package fastly
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"strings"
 
 	gofastly "github.com/fastly/go-fastly/v9/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -23,6 +25,10 @@ func resourceFastlyAlert() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customdiff.All(
+			validateAlertDefinition,
+			validateAlertServiceID,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"description": {
@@ -173,7 +179,7 @@ func resourceFastlyAlertCreate(_ context.Context, d *schema.ResourceData, meta a
 
 	d.SetId(ad.ID)
 
-	return nil
+	return alertMetricDiags(d)
 }
 
 func resourceFastlyAlertRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -280,7 +286,7 @@ func resourceFastlyAlertUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
-	return resourceFastlyAlertRead(ctx, d, meta)
+	return append(alertMetricDiags(d), resourceFastlyAlertRead(ctx, d, meta)...)
 }
 
 func resourceFastlyAlertDelete(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -334,7 +340,10 @@ func buildEvaluationStrategy(v map[string]any) map[string]any {
 }
 
 func buildStringSlice(s *schema.Set) []string {
-	l := s.List()
+	return buildStringList(s.List())
+}
+
+func buildStringList(l []any) []string {
 	sl := make([]string, 0, len(l))
 	for _, i := range l {
 		if v, ok := i.(string); ok {
@@ -345,7 +354,14 @@ func buildStringSlice(s *schema.Set) []string {
 }
 
 func validateSourceWithServiceId(source string, serviceId string) error {
-	if source != "stats" && serviceId == "" {
+	if serviceId != "" {
+		return nil
+	}
+	s := alertDefinitions.source(source)
+	if s == nil {
+		return fmt.Errorf("unknown source %q, expected one of: %s", source, strings.Join(alertDefinitions.sourceNames(), ", "))
+	}
+	if s.RequiresServiceID {
 		return errors.New(badAlertSourceServiceIdConfig)
 	}
 
@@ -0,0 +1,361 @@
+package fastly
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// alertPolicyDefinitionKeys are the attributes of `fastly_alert` shared by
+// `fastly_alert_policy`, which applies them to every selected service.
+var alertPolicyDefinitionKeys = []string{
+	"description",
+	"dimensions",
+	"evaluation_strategy",
+	"integration_ids",
+	"metric",
+	"name",
+	"source",
+}
+
+func resourceFastlyAlertPolicy() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"alerts": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "A map of the service IDs the policy applies to, to the ID of the alert created for the service.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"service_ids": {
+			Type:         schema.TypeSet,
+			Optional:     true,
+			Description:  "The services to create the alert for. Conflicts with `service_name_regex`.",
+			Elem:         &schema.Schema{Type: schema.TypeString},
+			ExactlyOneOf: []string{"service_ids", "service_name_regex"},
+		},
+		"service_name_regex": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "A regular expression selecting the services to create the alert for by name. Services created or renamed later are picked up on the next plan. Conflicts with `service_ids`.",
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsValidRegExp),
+			ExactlyOneOf:     []string{"service_ids", "service_name_regex"},
+		},
+	}
+
+	alert := resourceFastlyAlert().Schema
+	for _, k := range alertPolicyDefinitionKeys {
+		s[k] = alert[k]
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceFastlyAlertPolicyCreate,
+		ReadContext:   resourceFastlyAlertPolicyRead,
+		UpdateContext: resourceFastlyAlertPolicyUpdate,
+		DeleteContext: resourceFastlyAlertPolicyDelete,
+		CustomizeDiff: customdiff.All(
+			validateAlertDefinition,
+			resourceFastlyAlertPolicyCustomizeDiff,
+		),
+		Schema: s,
+	}
+}
+
+func resourceFastlyAlertPolicyCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	d.SetId(id.UniqueId())
+
+	if err := syncAlertPolicy(d, meta.(*APIClient).conn, map[string]string{}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return append(alertMetricDiags(d), resourceFastlyAlertPolicyRead(ctx, d, meta)...)
+}
+
+func resourceFastlyAlertPolicyRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	log.Printf("[DEBUG] Refreshing Alert Policy Configuration for (%s)", d.Id())
+	conn := meta.(*APIClient).conn
+
+	alerts := buildStringMap(d.Get("alerts").(map[string]any))
+
+	// Alerts deleted outside of Terraform are dropped from the membership, so
+	// they are created again on the next apply.
+	var definition *gofastly.AlertDefinition
+	for _, serviceID := range sortedKeys(alerts) {
+		ad, err := conn.GetAlertDefinition(&gofastly.GetAlertDefinitionInput{
+			ID: gofastly.ToPointer(alerts[serviceID]),
+		})
+		if err != nil {
+			if e, ok := err.(*gofastly.HTTPError); ok && e.IsNotFound() {
+				log.Printf("[WARN] Alert (%s) for service (%s) not found, removing from policy (%s)", alerts[serviceID], serviceID, d.Id())
+				delete(alerts, serviceID)
+				continue
+			}
+			return diag.FromErr(err)
+		}
+		if definition == nil {
+			definition = ad
+		}
+	}
+
+	if err := d.Set("alerts", alerts); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The alerts are created from the same definition, so the first one is
+	// used to detect drift.
+	if definition == nil {
+		return nil
+	}
+	if err := d.Set("description", strings.TrimSpace(strings.TrimSuffix(definition.Description, ManagedByTerraform))); err != nil {
+		return diag.FromErr(err)
+	}
+	if len(definition.Dimensions) > 0 {
+		if err := d.Set("dimensions", flattenDimensions(definition.Dimensions)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if len(definition.EvaluationStrategy) > 0 {
+		if err := d.Set("evaluation_strategy", []map[string]any{definition.EvaluationStrategy}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := d.Set("integration_ids", definition.IntegrationIDs); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("metric", definition.Metric); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", definition.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("source", definition.Source); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceFastlyAlertPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	o, _ := d.GetChange("alerts")
+
+	if err := syncAlertPolicy(d, meta.(*APIClient).conn, buildStringMap(o.(map[string]any))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return append(alertMetricDiags(d), resourceFastlyAlertPolicyRead(ctx, d, meta)...)
+}
+
+func resourceFastlyAlertPolicyDelete(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	alerts := buildStringMap(d.Get("alerts").(map[string]any))
+	for _, serviceID := range sortedKeys(alerts) {
+		if err := deleteAlertPolicyAlert(conn, alerts[serviceID]); err != nil {
+			return diag.Errorf("error deleting alert (%s) for service (%s): %s", alerts[serviceID], serviceID, err)
+		}
+	}
+
+	return nil
+}
+
+// resourceFastlyAlertPolicyCustomizeDiff resolves the selected services so
+// that the plan shows a change to `alerts` when services join or leave the
+// policy.
+func resourceFastlyAlertPolicyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("service_ids") || !d.NewValueKnown("service_name_regex") {
+		return d.SetNewComputed("alerts")
+	}
+
+	if d.Id() == "" || d.HasChanges(alertPolicyDefinitionKeys...) {
+		return d.SetNewComputed("alerts")
+	}
+
+	serviceIDs, err := resolveAlertPolicyServices(d, meta.(*APIClient).conn)
+	if err != nil {
+		return err
+	}
+
+	current := sortedKeys(d.Get("alerts").(map[string]any))
+	if strings.Join(serviceIDs, ",") != strings.Join(current, ",") {
+		return d.SetNewComputed("alerts")
+	}
+
+	return nil
+}
+
+// resolveAlertPolicyServices returns the sorted IDs of the services the policy
+// applies to.
+func resolveAlertPolicyServices(d interface{ Get(string) any }, conn *gofastly.Client) ([]string, error) {
+	if v := d.Get("service_ids").(*schema.Set); v.Len() > 0 {
+		serviceIDs := buildStringSlice(v)
+		sort.Strings(serviceIDs)
+		return serviceIDs, nil
+	}
+
+	re, err := regexp.Compile(d.Get("service_name_regex").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	services, err := conn.ListServices(&gofastly.ListServicesInput{})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching services: %w", err)
+	}
+
+	return matchAlertPolicyServices(services, re), nil
+}
+
+// matchAlertPolicyServices returns the sorted IDs of the services whose name
+// matches re.
+func matchAlertPolicyServices(services []*gofastly.Service, re *regexp.Regexp) []string {
+	serviceIDs := []string{}
+	for _, s := range services {
+		if s.ServiceID != nil && re.MatchString(gofastly.ToValue(s.Name)) {
+			serviceIDs = append(serviceIDs, *s.ServiceID)
+		}
+	}
+	sort.Strings(serviceIDs)
+	return serviceIDs
+}
+
+// diffAlertPolicyServices splits the desired services into the services that
+// need an alert created and those that already have one, and returns the
+// services whose alert needs deleting.
+func diffAlertPolicyServices(alerts map[string]string, serviceIDs []string) (create, keep, remove []string) {
+	desired := make(map[string]bool, len(serviceIDs))
+	for _, serviceID := range serviceIDs {
+		desired[serviceID] = true
+		if _, ok := alerts[serviceID]; ok {
+			keep = append(keep, serviceID)
+		} else {
+			create = append(create, serviceID)
+		}
+	}
+	for _, serviceID := range sortedKeys(alerts) {
+		if !desired[serviceID] {
+			remove = append(remove, serviceID)
+		}
+	}
+	return create, keep, remove
+}
+
+// syncAlertPolicy creates, updates and deletes the per-service alerts so that
+// every selected service has an alert matching the definition. `alerts` is
+// saved after each change, so that a partial failure leaves it in line with
+// the alerts that exist.
+func syncAlertPolicy(d *schema.ResourceData, conn *gofastly.Client, alerts map[string]string) error {
+	serviceIDs, err := resolveAlertPolicyServices(d, conn)
+	if err != nil {
+		return err
+	}
+
+	create, keep, remove := diffAlertPolicyServices(alerts, serviceIDs)
+	log.Printf("[DEBUG] Alert policy (%s): creating %d, keeping %d, deleting %d alerts", d.Id(), len(create), len(keep), len(remove))
+
+	save := func(err error) error {
+		if serr := d.Set("alerts", alerts); serr != nil {
+			return serr
+		}
+		return err
+	}
+
+	description, dimensions, evaluationStrategy, integrationIDs := expandAlertPolicyDefinition(d)
+
+	for _, serviceID := range remove {
+		if err := deleteAlertPolicyAlert(conn, alerts[serviceID]); err != nil {
+			return save(fmt.Errorf("error deleting alert (%s) for service (%s): %w", alerts[serviceID], serviceID, err))
+		}
+		delete(alerts, serviceID)
+	}
+
+	if d.HasChanges(alertPolicyDefinitionKeys...) {
+		for _, serviceID := range keep {
+			_, err := conn.UpdateAlertDefinition(&gofastly.UpdateAlertDefinitionInput{
+				ID:                 gofastly.ToPointer(alerts[serviceID]),
+				Description:        gofastly.ToPointer(description),
+				Dimensions:         dimensions,
+				EvaluationStrategy: evaluationStrategy,
+				IntegrationIDs:     integrationIDs,
+				Metric:             gofastly.ToPointer(d.Get("metric").(string)),
+				Name:               gofastly.ToPointer(d.Get("name").(string)),
+			})
+			if err != nil {
+				return save(fmt.Errorf("error updating alert (%s) for service (%s): %w", alerts[serviceID], serviceID, err))
+			}
+		}
+	}
+
+	for _, serviceID := range create {
+		ad, err := conn.CreateAlertDefinition(&gofastly.CreateAlertDefinitionInput{
+			Description:        gofastly.ToPointer(description),
+			Dimensions:         dimensions,
+			EvaluationStrategy: evaluationStrategy,
+			IntegrationIDs:     integrationIDs,
+			Metric:             gofastly.ToPointer(d.Get("metric").(string)),
+			Name:               gofastly.ToPointer(d.Get("name").(string)),
+			ServiceID:          gofastly.ToPointer(serviceID),
+			Source:             gofastly.ToPointer(d.Get("source").(string)),
+		})
+		if err != nil {
+			return save(fmt.Errorf("error creating alert for service (%s): %w", serviceID, err))
+		}
+		alerts[serviceID] = ad.ID
+	}
+
+	return save(nil)
+}
+
+// expandAlertPolicyDefinition builds the parts of the alert definition shared
+// by the create and update inputs.
+func expandAlertPolicyDefinition(d *schema.ResourceData) (string, map[string][]string, map[string]any, []string) {
+	description := ManagedByTerraform
+	if v, ok := d.GetOk("description"); ok {
+		description = v.(string) + " " + ManagedByTerraform
+	}
+
+	dimensions := map[string][]string{}
+	if v, ok := d.GetOk("dimensions"); ok {
+		for _, r := range v.([]any) {
+			if m, ok := r.(map[string]any); ok {
+				dimensions = buildDimensions(dimensions, m)
+			}
+		}
+	}
+
+	var evaluationStrategy map[string]any
+	if v, ok := d.GetOk("evaluation_strategy"); ok {
+		for _, r := range v.([]any) {
+			if m, ok := r.(map[string]any); ok {
+				evaluationStrategy = buildEvaluationStrategy(m)
+			}
+		}
+	}
+
+	integrationIDs := []string{}
+	if v, ok := d.GetOk("integration_ids"); ok {
+		integrationIDs = buildStringSlice(v.(*schema.Set))
+	}
+
+	return description, dimensions, evaluationStrategy, integrationIDs
+}
+
+// deleteAlertPolicyAlert deletes an alert, ignoring alerts that no longer
+// exist.
+func deleteAlertPolicyAlert(conn *gofastly.Client, alertID string) error {
+	err := conn.DeleteAlertDefinition(&gofastly.DeleteAlertDefinitionInput{
+		ID: gofastly.ToPointer(alertID),
+	})
+	if e, ok := err.(*gofastly.HTTPError); ok && e.IsNotFound() {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,163 @@
+package fastly
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestMatchAlertPolicyServices(t *testing.T) {
+	services := []*gofastly.Service{
+		{ServiceID: gofastly.ToPointer("c"), Name: gofastly.ToPointer("prod-www")},
+		{ServiceID: gofastly.ToPointer("a"), Name: gofastly.ToPointer("prod-api")},
+		{ServiceID: gofastly.ToPointer("b"), Name: gofastly.ToPointer("staging-api")},
+		{Name: gofastly.ToPointer("prod-missing-id")},
+	}
+
+	got := matchAlertPolicyServices(services, regexp.MustCompile("^prod-"))
+	if diff := cmp.Diff([]string{"a", "c"}, got); diff != "" {
+		t.Errorf("unexpected services (-want +got):\n%s", diff)
+	}
+}
+
+func TestDiffAlertPolicyServices(t *testing.T) {
+	alerts := map[string]string{
+		"a": "alert-a",
+		"b": "alert-b",
+	}
+
+	create, keep, remove := diffAlertPolicyServices(alerts, []string{"b", "c"})
+	if diff := cmp.Diff([]string{"c"}, create); diff != "" {
+		t.Errorf("unexpected create (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"b"}, keep); diff != "" {
+		t.Errorf("unexpected keep (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"a"}, remove); diff != "" {
+		t.Errorf("unexpected remove (-want +got):\n%s", diff)
+	}
+}
+
+func TestAccFastlyAlertPolicy_Basic(t *testing.T) {
+	prefix := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	alertName := fmt.Sprintf("alert %s", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckAlertPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlertPolicyConfig(prefix, alertName, 2, "status_5xx"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_alert_policy.example", "alerts.%", "2"),
+					testAccCheckFastlyAlertPolicyRemoteState("fastly_alert_policy.example", "status_5xx"),
+				),
+			},
+			{
+				Config: testAccAlertPolicyConfig(prefix, alertName, 3, "status_4xx"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_alert_policy.example", "alerts.%", "3"),
+					testAccCheckFastlyAlertPolicyRemoteState("fastly_alert_policy.example", "status_4xx"),
+				),
+			},
+			{
+				Config: testAccAlertPolicyConfig(prefix, alertName, 1, "status_4xx"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_alert_policy.example", "alerts.%", "1"),
+					testAccCheckFastlyAlertPolicyRemoteState("fastly_alert_policy.example", "status_4xx"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFastlyAlertPolicyRemoteState(name, metric string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*APIClient).conn
+		for k, v := range rs.Primary.Attributes {
+			serviceID, ok := strings.CutPrefix(k, "alerts.")
+			if !ok || serviceID == "%" {
+				continue
+			}
+			ad, err := conn.GetAlertDefinition(&gofastly.GetAlertDefinitionInput{
+				ID: gofastly.ToPointer(v),
+			})
+			if err != nil {
+				return fmt.Errorf("error looking up alert (%s): %s", v, err)
+			}
+			if ad.ServiceID != serviceID {
+				return fmt.Errorf("bad service for alert (%s), expected (%s), got (%s)", v, serviceID, ad.ServiceID)
+			}
+			if ad.Metric != metric {
+				return fmt.Errorf("bad metric for alert (%s), expected (%s), got (%s)", v, metric, ad.Metric)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckAlertPolicyDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fastly_alert_policy" {
+			continue
+		}
+
+		conn := testAccProvider.Meta().(*APIClient).conn
+		adr, err := conn.ListAlertDefinitions(&gofastly.ListAlertDefinitionsInput{})
+		if err != nil {
+			return fmt.Errorf("error listing alert definitions when checking alert policy destroy (%s): %s", rs.Primary.ID, err)
+		}
+
+		for _, ad := range adr.Data {
+			for k, v := range rs.Primary.Attributes {
+				if strings.HasPrefix(k, "alerts.") && ad.ID == v {
+					return fmt.Errorf("tried deleting alert (%s) of policy (%s), but was still found", ad.ID, rs.Primary.ID)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func testAccAlertPolicyConfig(prefix, alertName string, services int, metric string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_vcl" "example" {
+  count = %d
+  name  = "%s-${count.index}"
+
+  domain {
+    name = "%s-${count.index}.example.com"
+  }
+
+  force_destroy = true
+}
+
+resource "fastly_alert_policy" "example" {
+  name        = "%s"
+  service_ids = fastly_service_vcl.example[*].id
+  source      = "stats"
+  metric      = "%s"
+
+  evaluation_strategy {
+    type      = "above_threshold"
+    period    = "5m"
+    threshold = 10
+  }
+}
+`, services, prefix, prefix, alertName, metric)
+}
@@ -368,6 +368,37 @@ func testAccCheckFastlyAlertsRemoteState(service *gofastly.ServiceDetail, servic
 	}
 }
 
+func TestAccFastlyAlert_InvalidMetric(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+	alert := gofastly.AlertDefinition{
+		Dimensions: map[string][]string{},
+		EvaluationStrategy: map[string]any{
+			"type":      "above_threshold",
+			"period":    "5m",
+			"threshold": float64(10),
+		},
+		Metric: "hit_ratio",
+		Name:   fmt.Sprintf("alert %s", acctest.RandString(10)),
+		Source: "origins",
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAlertConfig(serviceName, domainName, alert),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`metric "hit_ratio" is not available for source "origins"`),
+			},
+		},
+	})
+}
+
 func testAccCheckAlertDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "fastly_alert" {
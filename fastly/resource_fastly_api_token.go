@@ -0,0 +1,366 @@
+package fastly
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceFastlyAPIToken() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFastlyAPITokenCreate,
+		ReadContext:   resourceFastlyAPITokenRead,
+		UpdateContext: resourceFastlyAPITokenUpdate,
+		DeleteContext: resourceFastlyAPITokenDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: tokenRotationCustomizeDiff,
+		Schema: tokenSchema(map[string]*schema.Schema{
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The password of the user the token is assigned to. Creating a token requires re-authenticating with the user's credentials.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The email address (login) of the user the token is assigned to.",
+			},
+		}),
+	}
+}
+
+// tokenSchema returns the attributes shared by the API token and automation
+// token resources, merged with the resource specific attributes.
+func tokenSchema(attributes map[string]*schema.Schema) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"access_token": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The secret token used to authenticate requests to the Fastly API. It is only available when the token is created, and is not populated on import.",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Date and time in ISO 8601 format.",
+		},
+		"expires_at": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ForceNew:         true,
+			Description:      "Date and time in ISO 8601 format when the token expires. Conflicts with `ttl`. If neither is set, the token does not expire.",
+			ConflictsWith:    []string{"ttl"},
+			DiffSuppressFunc: suppressEquivalentRFC3339Times,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+		},
+		"last_used_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Date and time in ISO 8601 format when the token was last used.",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The name of the token.",
+		},
+		"ready_for_rotation": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Set to `true` during planning when the token expires within the `rotate_before` window, which causes the token to be replaced.",
+		},
+		"rotate_before": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "A duration (e.g. `168h`) before `expires_at` from which the token is replaced with a new one on the next apply. Requires `ttl`, so that the new token gets a new expiry.",
+			RequiredWith:     []string{"ttl"},
+			ValidateDiagFunc: validateDuration(),
+		},
+		"scopes": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "The authorization scopes of the token. Can be any of `global`, `purge_select`, `purge_all` and `global:read`. Defaults to `global` when not set.",
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validateTokenScope(),
+			},
+		},
+		"services": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "The IDs of the services the token is restricted to. If not set, the token has access to all services on the account.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"ttl": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			Description:      "A duration (e.g. `720h`) after which the token expires, counted from when it is created. Conflicts with `expires_at`.",
+			ConflictsWith:    []string{"expires_at"},
+			ValidateDiagFunc: validateDuration(),
+		},
+		"user_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the user the token is assigned to.",
+		},
+	}
+	for k, v := range attributes {
+		s[k] = v
+	}
+	return s
+}
+
+func resourceFastlyAPITokenCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	input := &gofastly.CreateTokenInput{
+		Name:     gofastly.ToPointer(d.Get("name").(string)),
+		Password: gofastly.ToPointer(d.Get("password").(string)),
+		Username: gofastly.ToPointer(d.Get("username").(string)),
+	}
+
+	if v, ok := d.GetOk("scopes"); ok {
+		input.Scope = gofastly.ToPointer(expandTokenScopes(v.(*schema.Set)))
+	}
+	if v, ok := d.GetOk("services"); ok {
+		input.Services = buildStringSlice(v.(*schema.Set))
+	}
+
+	expiresAt, err := tokenExpiresAt(d, time.Now())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !expiresAt.IsZero() {
+		input.ExpiresAt = &expiresAt
+	}
+
+	log.Printf("[DEBUG] CREATE: API Token input: name %s, username %s, scope %v, services %v", gofastly.ToValue(input.Name), gofastly.ToValue(input.Username), input.Scope, input.Services)
+
+	token, err := conn.CreateToken(input)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(gofastly.ToValue(token.TokenID))
+
+	if err := d.Set("access_token", gofastly.ToValue(token.AccessToken)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ready_for_rotation", false); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceFastlyAPITokenRead(ctx, d, meta)
+}
+
+func resourceFastlyAPITokenRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	log.Printf("[DEBUG] REFRESH: API Token (%s)", d.Id())
+
+	// NOTE: There is no endpoint for reading a single API token, so the token
+	// is looked up among the tokens of the customer. The tokens of the user
+	// authenticated by the provider would miss tokens created for other users.
+	user, err := conn.GetCurrentUser()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tokens, err := conn.ListCustomerTokens(&gofastly.ListCustomerTokensInput{
+		CustomerID: gofastly.ToValue(user.CustomerID),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var token *gofastly.Token
+	for _, t := range tokens {
+		if gofastly.ToValue(t.TokenID) == d.Id() {
+			token = t
+			break
+		}
+	}
+	if token == nil {
+		log.Printf("[WARN] No API Token found '%s'", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	err = flattenToken(d, tokenAttributes{
+		CreatedAt:  token.CreatedAt,
+		ExpiresAt:  token.ExpiresAt,
+		LastUsedAt: token.LastUsedAt,
+		Name:       token.Name,
+		Scope:      token.Scope,
+		Services:   token.Services,
+		UserID:     token.UserID,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// NOTE: Tokens cannot be modified, every attribute that affects the token
+// forces a new resource. Only provider-side attributes (e.g. `rotate_before`)
+// can be updated.
+func resourceFastlyAPITokenUpdate(_ context.Context, _ *schema.ResourceData, _ any) diag.Diagnostics {
+	return nil
+}
+
+func resourceFastlyAPITokenDelete(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	input := &gofastly.DeleteTokenInput{
+		TokenID: d.Id(),
+	}
+
+	log.Printf("[DEBUG] DELETE: API Token input: %#v", input)
+
+	err := conn.DeleteToken(input)
+	if err != nil {
+		if e, ok := err.(*gofastly.HTTPError); ok && e.IsNotFound() {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// tokenAttributes holds the attributes shared by API tokens and automation
+// tokens as returned by the Fastly API.
+type tokenAttributes struct {
+	CreatedAt  *time.Time
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	Name       *string
+	Scope      *gofastly.TokenScope
+	Services   []string
+	UserID     *string
+}
+
+// flattenToken sets the shared token attributes into the state.
+func flattenToken(d *schema.ResourceData, t tokenAttributes) error {
+	attrs := map[string]any{
+		"created_at":   formatRFC3339Time(t.CreatedAt),
+		"expires_at":   formatRFC3339Time(t.ExpiresAt),
+		"last_used_at": formatRFC3339Time(t.LastUsedAt),
+		"name":         gofastly.ToValue(t.Name),
+		"scopes":       flattenTokenScope(t.Scope),
+		"services":     t.Services,
+		"user_id":      gofastly.ToValue(t.UserID),
+	}
+	for k, v := range attrs {
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("error setting %s: %w", k, err)
+		}
+	}
+	return nil
+}
+
+// tokenRotationCustomizeDiff forces the replacement of a token once it
+// expires within the configured `rotate_before` window.
+func tokenRotationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	due, err := tokenRotationDue(d.Get("rotate_before").(string), d.Get("expires_at").(string), time.Now())
+	if err != nil || !due {
+		return err
+	}
+
+	if err := d.SetNew("ready_for_rotation", true); err != nil {
+		return err
+	}
+	return d.ForceNew("ready_for_rotation")
+}
+
+// tokenRotationDue reports whether a token expiring at `expiresAt` falls
+// within the `rotateBefore` window at `now`.
+func tokenRotationDue(rotateBefore, expiresAt string, now time.Time) (bool, error) {
+	if rotateBefore == "" || expiresAt == "" {
+		return false, nil
+	}
+
+	window, err := time.ParseDuration(rotateBefore)
+	if err != nil {
+		return false, fmt.Errorf("error parsing rotate_before: %w", err)
+	}
+	expiry, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return false, fmt.Errorf("error parsing expires_at: %w", err)
+	}
+
+	return !now.Add(window).Before(expiry), nil
+}
+
+// tokenExpiresAt returns the expiry configured with either `expires_at` or
+// `ttl`, or the zero time when the token should not expire.
+func tokenExpiresAt(d *schema.ResourceData, now time.Time) (time.Time, error) {
+	if v, ok := d.GetOk("ttl"); ok {
+		ttl, err := time.ParseDuration(v.(string))
+		if err != nil {
+			return time.Time{}, fmt.Errorf("error parsing ttl: %w", err)
+		}
+		return now.Add(ttl).UTC().Truncate(time.Second), nil
+	}
+	if v, ok := d.GetOk("expires_at"); ok {
+		expiresAt, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return time.Time{}, fmt.Errorf("error parsing expires_at: %w", err)
+		}
+		return expiresAt, nil
+	}
+	return time.Time{}, nil
+}
+
+// expandTokenScopes converts a set of scopes into the space-delimited
+// format expected by the Fastly API.
+func expandTokenScopes(set *schema.Set) gofastly.TokenScope {
+	scopes := buildStringSlice(set)
+	sort.Strings(scopes)
+	return gofastly.TokenScope(strings.Join(scopes, " "))
+}
+
+// flattenTokenScope converts a space-delimited scope into a list of scopes.
+func flattenTokenScope(scope *gofastly.TokenScope) []string {
+	if scope == nil {
+		return nil
+	}
+	return strings.Fields(string(*scope))
+}
+
+// suppressEquivalentRFC3339Times suppresses the diff between two timestamps
+// that represent the same instant in different time zones.
+func suppressEquivalentRFC3339Times(_, o, n string, _ *schema.ResourceData) bool {
+	ot, err := time.Parse(time.RFC3339, o)
+	if err != nil {
+		return false
+	}
+	nt, err := time.Parse(time.RFC3339, n)
+	if err != nil {
+		return false
+	}
+	return ot.Equal(nt)
+}
@@ -0,0 +1,58 @@
+package fastly
+
+import (
+	"testing"
+	"time"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestTokenRotationDue(t *testing.T) {
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	for name, testCase := range map[string]struct {
+		rotateBefore string
+		expiresAt    string
+		expected     bool
+		expectErr    bool
+	}{
+		"no window":          {"", "2025-01-11T12:00:00Z", false, false},
+		"no expiry":          {"24h", "", false, false},
+		"outside window":     {"24h", "2025-01-12T12:00:00Z", false, false},
+		"at window boundary": {"24h", "2025-01-11T12:00:00Z", true, false},
+		"inside window":      {"48h", "2025-01-11T12:00:00Z", true, false},
+		"already expired":    {"1h", "2025-01-01T00:00:00Z", true, false},
+		"other time zone":    {"1h", "2025-01-10T13:30:00+01:00", true, false},
+		"invalid window":     {"one day", "2025-01-11T12:00:00Z", false, true},
+		"invalid expiry":     {"24h", "tomorrow", false, true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			actual, err := tokenRotationDue(testCase.rotateBefore, testCase.expiresAt, now)
+			if (err != nil) != testCase.expectErr {
+				t.Fatalf("expected error %t, got %v", testCase.expectErr, err)
+			}
+			if actual != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestTokenScopes(t *testing.T) {
+	set := schema.NewSet(schema.HashString, []any{"purge_select", "global:read"})
+
+	scope := expandTokenScopes(set)
+	if scope != "global:read purge_select" {
+		t.Errorf("unexpected scope: %q", scope)
+	}
+
+	scopes := flattenTokenScope(gofastly.ToPointer(scope))
+	if len(scopes) != 2 || scopes[0] != "global:read" || scopes[1] != "purge_select" {
+		t.Errorf("unexpected scopes: %v", scopes)
+	}
+
+	if flattenTokenScope(nil) != nil {
+		t.Error("expected nil scopes for a nil scope")
+	}
+}
@@ -0,0 +1,230 @@
+package fastly
+
+import (
+	"context"
+	"log"
+	"time"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceFastlyAutomationToken() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFastlyAutomationTokenCreate,
+		ReadContext:   resourceFastlyAutomationTokenRead,
+		UpdateContext: resourceFastlyAutomationTokenUpdate,
+		DeleteContext: resourceFastlyAutomationTokenDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: tokenRotationCustomizeDiff,
+		Schema: tokenSchema(map[string]*schema.Schema{
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The password of the user creating the token. Creating an automation token requires elevating the provider's credentials with the user's credentials.",
+			},
+			"role": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "The role of the token. Can be `billing`, `engineer` or `user`.",
+				ValidateDiagFunc: validateAutomationTokenRole(),
+			},
+			"tls_access": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether the token can manage TLS configuration. Default `false`.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The email address (login) of the user creating the token.",
+			},
+		}),
+	}
+}
+
+func resourceFastlyAutomationTokenCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	input, err := expandAutomationTokenInput(d, time.Now())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] CREATE: Automation Token input: name %s, role %s, scope %v, services %v", input.Name, input.Role, input.Scope, input.Services)
+
+	token, err := createAutomationToken(conn, input)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(gofastly.ToValue(token.TokenID))
+
+	if err := d.Set("access_token", gofastly.ToValue(token.AccessToken)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ready_for_rotation", false); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceFastlyAutomationTokenRead(ctx, d, meta)
+}
+
+func resourceFastlyAutomationTokenRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	input := &gofastly.GetAutomationTokenInput{
+		TokenID: d.Id(),
+	}
+
+	log.Printf("[DEBUG] REFRESH: Automation Token input: %#v", input)
+
+	token, err := conn.GetAutomationToken(input)
+	if err != nil {
+		if e, ok := err.(*gofastly.HTTPError); ok && e.IsNotFound() {
+			log.Printf("[WARN] No Automation Token found '%s'", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	err = flattenToken(d, tokenAttributes{
+		CreatedAt:  token.CreatedAt,
+		ExpiresAt:  token.ExpiresAt,
+		LastUsedAt: token.LastUsedAt,
+		Name:       token.Name,
+		Scope:      token.Scope,
+		Services:   token.Services,
+		UserID:     token.UserID,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if token.Role != nil {
+		if err := d.Set("role", string(*token.Role)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if token.TLSAccess != nil {
+		if err := d.Set("tls_access", *token.TLSAccess); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// expandAutomationTokenInput builds the input creating the automation token.
+// ExpiresAt is left zero when no expiry is configured, see
+// createAutomationToken.
+func expandAutomationTokenInput(d *schema.ResourceData, now time.Time) (*gofastly.CreateAutomationTokenInput, error) {
+	input := &gofastly.CreateAutomationTokenInput{
+		Name:      d.Get("name").(string),
+		Password:  gofastly.ToPointer(d.Get("password").(string)),
+		Role:      gofastly.AutomationTokenRole(d.Get("role").(string)),
+		TLSAccess: d.Get("tls_access").(bool),
+		Username:  gofastly.ToPointer(d.Get("username").(string)),
+	}
+
+	if v, ok := d.GetOk("scopes"); ok {
+		input.Scope = gofastly.ToPointer(expandTokenScopes(v.(*schema.Set)))
+	}
+	if v, ok := d.GetOk("services"); ok {
+		input.Services = buildStringSlice(v.(*schema.Set))
+	}
+
+	expiresAt, err := tokenExpiresAt(d, now)
+	if err != nil {
+		return nil, err
+	}
+	if !expiresAt.IsZero() {
+		input.ExpiresAt = expiresAt
+	}
+
+	return input, nil
+}
+
+// automationTokenRequest is the body of the request creating an automation
+// token. gofastly.CreateAutomationTokenInput always sends `expires_at` (as
+// `0001-01-01T00:00:00Z` when it is zero), so the field is left out here when
+// there is no expiry instead.
+type automationTokenRequest struct {
+	ExpiresAt *time.Time                   `json:"expires_at,omitempty"`
+	Name      string                       `json:"name"`
+	Role      gofastly.AutomationTokenRole `json:"role"`
+	Scope     *gofastly.TokenScope         `json:"scope,omitempty"`
+	Services  []string                     `json:"services"`
+	TLSAccess bool                         `json:"tls_access"`
+}
+
+// newAutomationTokenRequest returns the body of the request creating the
+// automation token of the input.
+func newAutomationTokenRequest(i *gofastly.CreateAutomationTokenInput) automationTokenRequest {
+	r := automationTokenRequest{
+		Name:      i.Name,
+		Role:      i.Role,
+		Scope:     i.Scope,
+		Services:  i.Services,
+		TLSAccess: i.TLSAccess,
+	}
+	if !i.ExpiresAt.IsZero() {
+		expiresAt := i.ExpiresAt
+		r.ExpiresAt = &expiresAt
+	}
+	return r
+}
+
+// createAutomationToken creates an automation token like
+// conn.CreateAutomationToken, without sending an expiry when none is set.
+func createAutomationToken(conn *gofastly.Client, i *gofastly.CreateAutomationTokenInput) (*gofastly.AutomationToken, error) {
+	if _, err := conn.PostForm("/sudo", i, nil); err != nil {
+		return nil, err
+	}
+
+	resp, err := conn.PostJSON("/automation-tokens", newAutomationTokenRequest(i), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var t *gofastly.AutomationToken
+	if err := gofastly.DecodeBodyMap(resp.Body, &t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// NOTE: Tokens cannot be modified, every attribute that affects the token
+// forces a new resource. Only provider-side attributes (e.g. `rotate_before`)
+// can be updated.
+func resourceFastlyAutomationTokenUpdate(_ context.Context, _ *schema.ResourceData, _ any) diag.Diagnostics {
+	return nil
+}
+
+func resourceFastlyAutomationTokenDelete(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	input := &gofastly.DeleteAutomationTokenInput{
+		TokenID: d.Id(),
+	}
+
+	log.Printf("[DEBUG] DELETE: Automation Token input: %#v", input)
+
+	err := conn.DeleteAutomationToken(input)
+	if err != nil {
+		if e, ok := err.(*gofastly.HTTPError); ok && e.IsNotFound() {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
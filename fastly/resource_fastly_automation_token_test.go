@@ -0,0 +1,182 @@
+package fastly
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestExpandAutomationTokenInput(t *testing.T) {
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	for name, testCase := range map[string]struct {
+		config    map[string]any
+		expiresAt time.Time
+	}{
+		"no expiry": {
+			config:    map[string]any{},
+			expiresAt: time.Time{},
+		},
+		"ttl": {
+			config:    map[string]any{"ttl": "24h"},
+			expiresAt: time.Date(2025, 1, 11, 12, 0, 0, 0, time.UTC),
+		},
+		"expires_at": {
+			config:    map[string]any{"expires_at": "2025-02-01T00:00:00Z"},
+			expiresAt: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			config := map[string]any{
+				"name":     "example",
+				"password": "secret",
+				"role":     "engineer",
+				"username": "user@example.com",
+			}
+			for k, v := range testCase.config {
+				config[k] = v
+			}
+			d := schema.TestResourceDataRaw(t, resourceFastlyAutomationToken().Schema, config)
+
+			input, err := expandAutomationTokenInput(d, now)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !input.ExpiresAt.Equal(testCase.expiresAt) {
+				t.Errorf("expected expiry %s, got %s", testCase.expiresAt, input.ExpiresAt)
+			}
+			if gofastly.ToValue(input.Username) != "user@example.com" || gofastly.ToValue(input.Password) != "secret" {
+				t.Errorf("expected the credentials to be set, got %q", gofastly.ToValue(input.Username))
+			}
+
+			body, err := json.Marshal(newAutomationTokenRequest(input))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			var fields map[string]any
+			if err := json.Unmarshal(body, &fields); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if _, ok := fields["expires_at"]; ok != !testCase.expiresAt.IsZero() {
+				t.Errorf("unexpected expires_at in the request body: %s", body)
+			}
+			if _, ok := fields["password"]; ok {
+				t.Errorf("unexpected password in the request body: %s", body)
+			}
+		})
+	}
+}
+
+func TestAccFastlyAutomationToken_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix(testResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckAutomationTokenDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationTokenConfig(name, "168h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_automation_token.example", "name", name),
+					resource.TestCheckResourceAttr("fastly_automation_token.example", "role", "engineer"),
+					resource.TestCheckResourceAttr("fastly_automation_token.example", "scopes.#", "1"),
+					resource.TestCheckResourceAttr("fastly_automation_token.example", "ready_for_rotation", "false"),
+					resource.TestCheckResourceAttrSet("fastly_automation_token.example", "access_token"),
+					resource.TestCheckResourceAttrSet("fastly_automation_token.example", "expires_at"),
+				),
+			},
+			{
+				// A rotation window larger than the remaining lifetime forces a replacement.
+				Config:             testAccAutomationTokenConfig(name, "1000h"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				ResourceName:            "fastly_automation_token.example",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"access_token", "password", "rotate_before", "ttl", "username"},
+			},
+		},
+	})
+}
+
+// TestAccFastlyAutomationToken_noExpiry checks that a token without `ttl` or
+// `expires_at` is created without an expiry.
+func TestAccFastlyAutomationToken_noExpiry(t *testing.T) {
+	name := acctest.RandomWithPrefix(testResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckAutomationTokenDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationTokenNoExpiryConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_automation_token.example", "name", name),
+					resource.TestCheckResourceAttrSet("fastly_automation_token.example", "access_token"),
+					resource.TestCheckResourceAttr("fastly_automation_token.example", "expires_at", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAutomationTokenDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fastly_automation_token" {
+			continue
+		}
+
+		conn := testAccProvider.Meta().(*APIClient).conn
+		_, err := conn.GetAutomationToken(&gofastly.GetAutomationTokenInput{
+			TokenID: rs.Primary.ID,
+		})
+		if err == nil {
+			return fmt.Errorf("tried deleting automation token (%s), but it still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+// testAccAutomationTokenConfig requires the credentials of the user creating
+// the token in FASTLY_TEST_USERNAME and FASTLY_TEST_PASSWORD.
+func testAccAutomationTokenConfig(name, rotateBefore string) string {
+	return fmt.Sprintf(`
+resource "fastly_automation_token" "example" {
+  name          = "%s"
+  username      = "%s"
+  password      = "%s"
+  role          = "engineer"
+  scopes        = ["purge_select"]
+  ttl           = "720h"
+  rotate_before = "%s"
+}
+`, name, os.Getenv("FASTLY_TEST_USERNAME"), os.Getenv("FASTLY_TEST_PASSWORD"), rotateBefore)
+}
+
+func testAccAutomationTokenNoExpiryConfig(name string) string {
+	return fmt.Sprintf(`
+resource "fastly_automation_token" "example" {
+  name     = "%s"
+  username = "%s"
+  password = "%s"
+  role     = "engineer"
+  scopes   = ["purge_select"]
+}
+`, name, os.Getenv("FASTLY_TEST_USERNAME"), os.Getenv("FASTLY_TEST_PASSWORD"))
+}
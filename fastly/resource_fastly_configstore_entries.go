@@ -1,14 +1,22 @@
 package fastly
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
 	"log"
+	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 
 	gofastly "github.com/fastly/go-fastly/v9/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceFastlyConfigStoreEntries() *schema.Resource {
@@ -20,17 +28,55 @@ func resourceFastlyConfigStoreEntries() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceConfigStoreEntriesImport,
 		},
+		CustomizeDiff: resourceFastlyConfigStoreEntriesCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"entries": {
-				Type:        schema.TypeMap,
-				Required:    true,
-				Description: "A map representing an entry in the Config Store, (key/value)",
-				Elem:        schema.TypeString,
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Computed:     true,
+				Description:  "A map representing an entry in the Config Store, (key/value). Exactly one of `entries`, `entries_file` or `entries_json` must be specified",
+				Elem:         schema.TypeString,
+				ExactlyOneOf: []string{"entries", "entries_file", "entries_json"},
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					// Suppress the diff unless the user wishes Terraform to manage the entries.
-					return !d.HasChange("store_id") && !d.Get("manage_entries").(bool)
+					if !d.HasChange("store_id") && !d.Get("manage_entries").(bool) && d.Get("key_prefix").(string) == "" {
+						return true
+					}
+					// When the entries are loaded from a file or JSON document,
+					// `entries` only reflects the remote state and the changes are
+					// summarised by `entries_changes` instead.
+					return d.Get("entries_file").(string) != "" || d.Get("entries_json").(string) != ""
 				},
 			},
+			"entries_changes": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "A summary of the keys `added`, `changed` and `removed` by the latest change to the entries. Each value is the number of keys followed by (up to 10 of) their names.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"entries_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The path to a file containing the entries, either a JSON object of string values (`.json`) or a CSV file with a key and a value column (`.csv`). A first CSV row of `key,value` is treated as a header. Exactly one of `entries`, `entries_file` or `entries_json` must be specified",
+				ExactlyOneOf: []string{"entries", "entries_file", "entries_json"},
+			},
+			"entries_hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A SHA256 hash of the entries in the Config Store. A change in the entries loaded from `entries_file` or `entries_json` shows as a change of this hash.",
+			},
+			"entries_json": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "A JSON object of string values representing the entries in the Config Store. Exactly one of `entries`, `entries_file` or `entries_json` must be specified",
+				ExactlyOneOf: []string{"entries", "entries_file", "entries_json"},
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"key_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only manage the entries whose key starts with this prefix, so that several teams can share a Config Store. Entries outside of the prefix are ignored (never read, diffed or deleted), entries within it are always managed (as if `manage_entries` was `true`). Every key in the entries must start with the prefix. The prefix can be changed (or added after an import) without replacing the resource.",
+			},
 			"manage_entries": {
 				Type:        schema.TypeBool,
 				Default:     false,
@@ -46,25 +92,21 @@ func resourceFastlyConfigStoreEntries() *schema.Resource {
 	}
 }
 
-func resourceFastlyConfigStoreEntriesCreate(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+func resourceFastlyConfigStoreEntriesCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	conn := meta.(*APIClient).conn
 
-	entries := d.Get("entries").(map[string]any)
 	storeID := d.Get("store_id").(string)
 
-	var batchEntries []*gofastly.BatchConfigStoreItem
-
-	for key, val := range entries {
-		batchEntries = append(batchEntries, &gofastly.BatchConfigStoreItem{
-			Operation: gofastly.CreateBatchOperation,
-			ItemKey:   key,
-			ItemValue: val.(string),
-		})
+	entries, err := expandConfigStoreEntries(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
+	batchEntries := buildConfigStoreBatch(nil, entries)
+
 	log.Printf("[DEBUG] CREATE: Config Store Entries")
 
-	err := executeBatchConfigStoreOperations(conn, storeID, batchEntries)
+	err = executeBatchConfigStoreOperations(conn, storeID, batchEntries)
 	if err != nil {
 		return diag.Errorf("error creating Config Store (%s) entries: %s", storeID, err)
 	}
@@ -72,7 +114,11 @@ func resourceFastlyConfigStoreEntriesCreate(_ context.Context, d *schema.Resourc
 	// NOTE: `id` is exposed as a read-only attribute.
 	d.SetId(fmt.Sprintf("%s/entries", storeID))
 
-	return nil
+	if err := d.Set("entries_changes", summarizeConfigStoreEntriesChanges(nil, entries)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceFastlyConfigStoreEntriesRead(ctx, d, meta)
 }
 
 func resourceFastlyConfigStoreEntriesRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -94,7 +140,13 @@ func resourceFastlyConfigStoreEntriesRead(_ context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	err = d.Set("entries", flattenConfigStoreEntries(remoteState))
+	entries := filterKeyPrefix(flattenConfigStoreEntries(remoteState), d.Get("key_prefix").(string))
+
+	err = d.Set("entries", entries)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("entries_hash", configStoreEntriesHash(entries))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -102,85 +154,287 @@ func resourceFastlyConfigStoreEntriesRead(_ context.Context, d *schema.ResourceD
 	return nil
 }
 
-func resourceFastlyConfigStoreEntriesUpdate(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+func resourceFastlyConfigStoreEntriesUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	conn := meta.(*APIClient).conn
 
 	storeID := d.Get("store_id").(string)
 
 	log.Printf("[DEBUG] UPDATE: Config Store Entries")
 
-	if d.HasChange("entries") {
-		var batchEntries []*gofastly.BatchConfigStoreItem
-
-		o, n := d.GetChange("entries")
-
-		om := o.(map[string]any)
-		nm := n.(map[string]any)
-
-		// Deletions
-		for key := range om {
-			if _, ok := nm[key]; !ok {
-				batchEntries = append(batchEntries, &gofastly.BatchConfigStoreItem{
-					Operation: gofastly.DeleteBatchOperation,
-					ItemKey:   key,
-				})
-			}
+	if d.HasChanges("entries", "entries_file", "entries_json", "entries_hash") {
+		// The state holds the entries as last read from the Config Store.
+		var old map[string]string
+		if !d.HasChange("store_id") {
+			o, _ := d.GetChange("entries")
+			old = configStoreManagedEntries(o, d.Get("key_prefix").(string))
 		}
 
-		for key, val := range nm {
-			// Updates
-			if _, ok := om[key]; ok {
-				batchEntries = append(batchEntries, &gofastly.BatchConfigStoreItem{
-					Operation: gofastly.UpdateBatchOperation,
-					ItemKey:   key,
-					ItemValue: val.(string),
-				})
-			}
-
-			// Additions
-			if _, ok := om[key]; !ok {
-				batchEntries = append(batchEntries, &gofastly.BatchConfigStoreItem{
-					Operation: gofastly.CreateBatchOperation,
-					ItemKey:   key,
-					ItemValue: val.(string),
-				})
-			}
+		entries, err := expandConfigStoreEntries(d)
+		if err != nil {
+			return diag.FromErr(err)
 		}
 
-		err := executeBatchConfigStoreOperations(conn, storeID, batchEntries)
+		batchEntries := buildConfigStoreBatch(old, entries)
+
+		err = executeBatchConfigStoreOperations(conn, storeID, batchEntries)
 		if err != nil {
 			return diag.Errorf("error updating Config Store (%s) elements: %s", storeID, err)
 		}
+
+		if err := d.Set("entries_changes", summarizeConfigStoreEntriesChanges(old, entries)); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
-	return nil
+	return resourceFastlyConfigStoreEntriesRead(ctx, d, meta)
 }
 
 func resourceFastlyConfigStoreEntriesDelete(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	conn := meta.(*APIClient).conn
 
-	entries := d.Get("entries").(map[string]any)
+	entries := configStoreManagedEntries(d.Get("entries"), d.Get("key_prefix").(string))
 	storeID := d.Get("store_id").(string)
 
 	log.Printf("[DEBUG] DELETE: Config Store Entries")
 
-	var batchEntries []*gofastly.BatchConfigStoreItem
+	// Deleting the entries is turning them into no entries.
+	batchEntries := buildConfigStoreBatch(entries, nil)
+
+	err := executeBatchConfigStoreOperations(conn, storeID, batchEntries)
+	if err != nil {
+		return diag.Errorf("error deleting Config Store (%s) entries: %s", storeID, err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourceFastlyConfigStoreEntriesCustomizeDiff compares the desired entries
+// with the entries last read from the Config Store, so that a change to a
+// (potentially very large) `entries_file` or `entries_json` shows in the plan
+// as a new `entries_hash` and a compact `entries_changes` summary.
+func resourceFastlyConfigStoreEntriesCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	// Mirrors the `entries` DiffSuppressFunc: unless Terraform manages the
+	// entries, they are only seeded when the entries resource is created.
+	if d.Id() != "" && !d.HasChange("store_id") && !d.Get("manage_entries").(bool) && d.Get("key_prefix").(string) == "" {
+		return nil
+	}
+
+	for _, key := range []string{"entries", "entries_file", "entries_json"} {
+		if !d.NewValueKnown(key) {
+			if err := d.SetNewComputed("entries_hash"); err != nil {
+				return err
+			}
+			return d.SetNewComputed("entries_changes")
+		}
+	}
+
+	entries, err := expandConfigStoreEntries(d)
+	if err != nil {
+		return err
+	}
+	if prefix := d.Get("key_prefix").(string); prefix != "" {
+		if err := checkKeyPrefix("entries", sortedKeys(entries), prefix); err != nil {
+			return err
+		}
+	}
+
+	var old map[string]string
+	if d.Id() != "" && !d.HasChange("store_id") {
+		o, _ := d.GetChange("entries")
+		old = configStoreManagedEntries(o, d.Get("key_prefix").(string))
+	}
+
+	hash := configStoreEntriesHash(entries)
+	if d.Id() != "" && hash == d.Get("entries_hash").(string) {
+		return nil
+	}
+
+	if err := d.SetNew("entries_hash", hash); err != nil {
+		return err
+	}
+	return d.SetNew("entries_changes", summarizeConfigStoreEntriesChanges(old, entries))
+}
+
+// configStoreManagedEntries returns the entries held in the state that are
+// within the prefix. The state may still hold entries outside of a newly set
+// prefix, which must be left alone.
+func configStoreManagedEntries(entries any, prefix string) map[string]string {
+	return filterKeyPrefix(buildStringMap(entries.(map[string]any)), prefix)
+}
+
+// expandConfigStoreEntries returns the desired entries from whichever of
+// `entries`, `entries_file` or `entries_json` is configured.
+func expandConfigStoreEntries(d interface{ Get(string) any }) (map[string]string, error) {
+	if v := d.Get("entries_file").(string); v != "" {
+		return readConfigStoreEntriesFile(v)
+	}
+	if v := d.Get("entries_json").(string); v != "" {
+		entries, err := parseConfigStoreEntriesJSON([]byte(v))
+		if err != nil {
+			return nil, fmt.Errorf("error parsing entries_json: %w", err)
+		}
+		return entries, nil
+	}
+	return buildStringMap(d.Get("entries").(map[string]any)), nil
+}
+
+// readConfigStoreEntriesFile reads the entries from a JSON or CSV file,
+// depending on the file extension.
+func readConfigStoreEntriesFile(filename string) (map[string]string, error) {
+	// G304 (CWE-22): Potential file inclusion via variable
+	// #nosec
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error reading entries_file: %w", err)
+	}
+
+	var entries map[string]string
+	switch ext := strings.ToLower(filepath.Ext(filename)); ext {
+	case ".json":
+		entries, err = parseConfigStoreEntriesJSON(data)
+	case ".csv":
+		entries, err = parseConfigStoreEntriesCSV(data)
+	default:
+		return nil, fmt.Errorf("unsupported entries_file extension %q (expected .json or .csv)", ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error parsing entries_file (%s): %w", filename, err)
+	}
+	return entries, nil
+}
+
+func parseConfigStoreEntriesJSON(data []byte) (map[string]string, error) {
+	var entries map[string]string
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func parseConfigStoreEntriesCSV(data []byte) (map[string]string, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = 2
 
-	for key := range entries {
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make(map[string]string, len(records))
+	for i, record := range records {
+		if i == 0 && record[0] == "key" && record[1] == "value" {
+			continue
+		}
+		if _, ok := entries[record[0]]; ok {
+			return nil, fmt.Errorf("duplicate key %q on line %d", record[0], i+1)
+		}
+		entries[record[0]] = record[1]
+	}
+	return entries, nil
+}
+
+// configStoreEntriesHash returns a SHA256 hash of the entries. The keys are
+// sorted by json.Marshal, so the hash does not depend on the order in which
+// the entries were read.
+func configStoreEntriesHash(entries map[string]string) string {
+	if entries == nil {
+		entries = map[string]string{}
+	}
+	data, _ := json.Marshal(entries)
+	return fmt.Sprintf("%x", sha256.Sum256(data))
+}
+
+// diffConfigStoreEntries returns the sorted keys that are added, changed and
+// removed when going from the old to the new entries.
+func diffConfigStoreEntries(old, new map[string]string) (added, changed, removed []string) {
+	for key, val := range new {
+		if o, ok := old[key]; !ok {
+			added = append(added, key)
+		} else if o != val {
+			changed = append(changed, key)
+		}
+	}
+	for key := range old {
+		if _, ok := new[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(changed)
+	sort.Strings(removed)
+	return added, changed, removed
+}
+
+// buildConfigStoreBatch returns the batch operations that turn the old entries
+// into the new entries. Unchanged entries are left alone.
+func buildConfigStoreBatch(old, new map[string]string) []*gofastly.BatchConfigStoreItem {
+	added, changed, removed := diffConfigStoreEntries(old, new)
+
+	batchEntries := make([]*gofastly.BatchConfigStoreItem, 0, len(added)+len(changed)+len(removed))
+
+	// Deletions
+	for _, key := range removed {
 		batchEntries = append(batchEntries, &gofastly.BatchConfigStoreItem{
 			Operation: gofastly.DeleteBatchOperation,
 			ItemKey:   key,
 		})
 	}
 
-	err := executeBatchConfigStoreOperations(conn, storeID, batchEntries)
-	if err != nil {
-		return diag.Errorf("error deleting Config Store (%s) entries: %s", storeID, err)
+	// Updates
+	for _, key := range changed {
+		batchEntries = append(batchEntries, &gofastly.BatchConfigStoreItem{
+			Operation: gofastly.UpdateBatchOperation,
+			ItemKey:   key,
+			ItemValue: new[key],
+		})
 	}
 
-	d.SetId("")
+	// Additions
+	for _, key := range added {
+		batchEntries = append(batchEntries, &gofastly.BatchConfigStoreItem{
+			Operation: gofastly.CreateBatchOperation,
+			ItemKey:   key,
+			ItemValue: new[key],
+		})
+	}
 
-	return nil
+	return batchEntries
+}
+
+// summarizeConfigStoreEntriesChanges models the keys added, changed and
+// removed into a format suitable for saving to Terraform state.
+func summarizeConfigStoreEntriesChanges(old, new map[string]string) map[string]string {
+	added, changed, removed := diffConfigStoreEntries(old, new)
+	return map[string]string{
+		"added":   summarizeKeys(added, 10),
+		"changed": summarizeKeys(changed, 10),
+		"removed": summarizeKeys(removed, 10),
+	}
+}
+
+// summarizeKeys returns the number of keys followed by up to max of their
+// names, e.g. `3 (a, b, c)` or `12 (a, b, ... and 10 more)`.
+func summarizeKeys(keys []string, max int) string {
+	if len(keys) == 0 {
+		return "0"
+	}
+	if len(keys) <= max {
+		return fmt.Sprintf("%d (%s)", len(keys), strings.Join(keys, ", "))
+	}
+	return fmt.Sprintf("%d (%s, ... and %d more)", len(keys), strings.Join(keys[:max], ", "), len(keys)-max)
+}
+
+// buildStringMap converts a map read from the schema into a map of strings.
+func buildStringMap(m map[string]any) map[string]string {
+	sm := make(map[string]string, len(m))
+	for k, v := range m {
+		if v, ok := v.(string); ok {
+			sm[k] = v
+		}
+	}
+	return sm
 }
 
 // flattenConfigStoreEntries models data into format suitable for saving to
@@ -194,9 +448,11 @@ func flattenConfigStoreEntries(remoteState []*gofastly.ConfigStoreItem) map[stri
 }
 
 // executeBatchConfigStoreOperations is called from with the Create, Update and
-// Delete methods.
+// Delete methods. The operations are sent in batches of the maximum number of
+// operations the API accepts per request.
 func executeBatchConfigStoreOperations(conn *gofastly.Client, storeID string, batchEntries []*gofastly.BatchConfigStoreItem) error {
 	batchSize := gofastly.BatchModifyMaximumOperations
+	batches := (len(batchEntries) + batchSize - 1) / batchSize
 
 	for i := 0; i < len(batchEntries); i += batchSize {
 		j := i + batchSize
@@ -209,7 +465,12 @@ func executeBatchConfigStoreOperations(conn *gofastly.Client, storeID string, ba
 			Items:   batchEntries[i:j],
 		})
 		if err != nil {
-			return err
+			// A batch is applied atomically, so every key in it has failed.
+			keys := make([]string, 0, j-i)
+			for _, item := range batchEntries[i:j] {
+				keys = append(keys, item.ItemKey)
+			}
+			return fmt.Errorf("batch %d of %d failed for keys %s (batches before it were applied): %w", i/batchSize+1, batches, summarizeKeys(keys, 20), err)
 		}
 	}
 
@@ -83,12 +83,142 @@ func TestAccFastlyConfigStoreEntries_validate(t *testing.T) {
 				ResourceName:            "fastly_configstore_entries.example",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"manage_entries"},
+				ImportStateVerifyIgnore: []string{"entries_changes", "manage_entries"},
 			},
 		},
 	})
 }
 
+func TestAccFastlyConfigStoreEntries_entriesJSON(t *testing.T) {
+	storeName := fmt.Sprintf("store_%s", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckServiceVCLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfigStoreEntriesJSON(storeName, `{"key1": "value1", "key2": "value2"}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFastlyServiceConfigStoreEntriesRemoteState(storeName, map[string]string{
+						"key1": "value1",
+						"key2": "value2",
+					}),
+					resource.TestCheckResourceAttr("fastly_configstore_entries.example", "entries_changes.added", "2 (key1, key2)"),
+				),
+			},
+			{
+				Config: testAccServiceConfigStoreEntriesJSON(storeName, `{"key2": "value2_updated", "key3": "value3"}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFastlyServiceConfigStoreEntriesRemoteState(storeName, map[string]string{
+						"key2": "value2_updated",
+						"key3": "value3",
+					}),
+					resource.TestCheckResourceAttr("fastly_configstore_entries.example", "entries_changes.added", "1 (key3)"),
+					resource.TestCheckResourceAttr("fastly_configstore_entries.example", "entries_changes.changed", "1 (key2)"),
+					resource.TestCheckResourceAttr("fastly_configstore_entries.example", "entries_changes.removed", "1 (key1)"),
+				),
+			},
+		},
+	})
+}
+
+func TestParseConfigStoreEntriesCSV(t *testing.T) {
+	for name, testcase := range map[string]struct {
+		data    string
+		want    map[string]string
+		wantErr bool
+	}{
+		"with header": {
+			data: "key,value\na,1\nb,\"2,3\"\n",
+			want: map[string]string{"a": "1", "b": "2,3"},
+		},
+		"without header": {
+			data: "a,1\n",
+			want: map[string]string{"a": "1"},
+		},
+		"duplicate key": {
+			data:    "a,1\na,2\n",
+			wantErr: true,
+		},
+		"wrong number of columns": {
+			data:    "a,1,2\n",
+			wantErr: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseConfigStoreEntriesCSV([]byte(testcase.data))
+			if (err != nil) != testcase.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !testcase.wantErr && !reflect.DeepEqual(got, testcase.want) {
+				t.Errorf("want %#v, got %#v", testcase.want, got)
+			}
+		})
+	}
+}
+
+func TestBuildConfigStoreBatch(t *testing.T) {
+	old := map[string]string{"keep": "1", "change": "1", "remove": "1"}
+	new := map[string]string{"keep": "1", "change": "2", "add": "1"}
+
+	var got []string
+	for _, item := range buildConfigStoreBatch(old, new) {
+		got = append(got, fmt.Sprintf("%s %s=%s", item.Operation, item.ItemKey, item.ItemValue))
+	}
+	want := []string{"delete remove=", "update change=2", "create add=1"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want %#v, got %#v", want, got)
+	}
+
+	summary := summarizeConfigStoreEntriesChanges(old, new)
+	wantSummary := map[string]string{"added": "1 (add)", "changed": "1 (change)", "removed": "1 (remove)"}
+	if !reflect.DeepEqual(summary, wantSummary) {
+		t.Errorf("want %#v, got %#v", wantSummary, summary)
+	}
+}
+
+func TestConfigStoreManagedEntries(t *testing.T) {
+	// The state may still hold entries of other teams, read before the prefix
+	// was set.
+	entries := map[string]any{"route/a": "1", "route/b": "2", "other": "3"}
+
+	var got []string
+	for _, item := range buildConfigStoreBatch(configStoreManagedEntries(entries, "route/"), nil) {
+		got = append(got, fmt.Sprintf("%s %s", item.Operation, item.ItemKey))
+	}
+	want := []string{"delete route/a", "delete route/b"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want %#v, got %#v", want, got)
+	}
+}
+
+func TestSummarizeKeys(t *testing.T) {
+	for _, testcase := range []struct {
+		keys []string
+		want string
+	}{
+		{nil, "0"},
+		{[]string{"a", "b"}, "2 (a, b)"},
+		{[]string{"a", "b", "c", "d"}, "4 (a, b, ... and 2 more)"},
+	} {
+		if got := summarizeKeys(testcase.keys, 2); got != testcase.want {
+			t.Errorf("want %q, got %q", testcase.want, got)
+		}
+	}
+}
+
+func TestConfigStoreEntriesHash(t *testing.T) {
+	if configStoreEntriesHash(nil) != configStoreEntriesHash(map[string]string{}) {
+		t.Errorf("expected no entries and empty entries to have the same hash")
+	}
+	if configStoreEntriesHash(map[string]string{"a": "1"}) == configStoreEntriesHash(map[string]string{"a": "2"}) {
+		t.Errorf("expected different entries to have different hashes")
+	}
+}
+
 func testAccServiceConfigStoreEntries(storeName string) string {
 	return fmt.Sprintf(`
 resource "fastly_configstore" "example" {
@@ -126,6 +256,21 @@ resource "fastly_configstore_entries" "example" {
 `, storeName)
 }
 
+func testAccServiceConfigStoreEntriesJSON(storeName, entries string) string {
+	return fmt.Sprintf(`
+resource "fastly_configstore" "example" {
+  name          = "%s"
+  force_destroy = true
+}
+
+resource "fastly_configstore_entries" "example" {
+  store_id       = fastly_configstore.example.id
+  entries_json   = %q
+  manage_entries = true
+}
+`, storeName, entries)
+}
+
 func testAccCheckFastlyServiceConfigStoreEntriesRemoteState(storeName string, want map[string]string) resource.TestCheckFunc {
 	return func(_ *terraform.State) error {
 		conn := testAccProvider.Meta().(*APIClient).conn
@@ -1,10 +1,14 @@
 package fastly
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
+	"reflect"
+	"strings"
 
 	gofastly "github.com/fastly/go-fastly/v9/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -122,16 +126,17 @@ func resourceFastlyCustomDashboard() *schema.Resource {
 		UpdateContext: resourceFastlyCustomDashboardUpdate,
 		DeleteContext: resourceFastlyCustomDashboardDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceFastlyCustomDashboardImport,
 		},
 
 		Schema: map[string]*schema.Schema{
 			"dashboard_item": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "A list of dashboard items.",
-				MinItems:    0,
-				MaxItems:    100,
+				Type:          schema.TypeList,
+				Optional:      true,
+				Description:   "A list of dashboard items. Conflicts with `dashboard_json`.",
+				MinItems:      0,
+				MaxItems:      100,
+				ConflictsWith: []string{"dashboard_json"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"data_source": &schemaDataSource,
@@ -160,6 +165,16 @@ func resourceFastlyCustomDashboard() *schema.Resource {
 					},
 				},
 			},
+			"dashboard_json": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "The dashboard items as a JSON document, as an alternative to `dashboard_item` blocks. Either the API's dashboard document (only its `items` are used) or a list of items, e.g. the `dashboard_json` of the `fastly_custom_dashboard` data source. Differences in formatting, key order, omitted defaults and omitted item IDs are ignored. Conflicts with `dashboard_item`.",
+				ConflictsWith:    []string{"dashboard_item"},
+				ValidateDiagFunc: validateDashboardJSON(),
+				DiffSuppressFunc: func(_, o, n string, _ *schema.ResourceData) bool {
+					return dashboardJSONEqual(o, n)
+				},
+			},
 			"description": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -230,7 +245,16 @@ func resourceFastlyCustomDashboardRead(_ context.Context, d *schema.ResourceData
 			return diag.FromErr(err)
 		}
 	}
-	if len(dash.Items) > 0 {
+	if _, ok := d.GetOk("dashboard_json"); ok {
+		dashboardJSON, err := normaliseDashboardItems(dash.Items)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = d.Set("dashboard_json", dashboardJSON)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else if len(dash.Items) > 0 {
 		itemList := flattenDashboardItems(dash.Items)
 		err = d.Set("dashboard_item", itemList)
 		if err != nil {
@@ -240,6 +264,27 @@ func resourceFastlyCustomDashboardRead(_ context.Context, d *schema.ResourceData
 	return nil
 }
 
+// resourceFastlyCustomDashboardImport imports a dashboard by ID. The items are
+// read into `dashboard_item` blocks, or into `dashboard_json` if the ID has a
+// `/dashboard_json` suffix: the config, which would tell which one is used, is
+// not available on import.
+func resourceFastlyCustomDashboardImport(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+	id, suffix, found := strings.Cut(d.Id(), "/")
+	if !found {
+		return []*schema.ResourceData{d}, nil
+	}
+	if suffix != "dashboard_json" {
+		return nil, fmt.Errorf("invalid id: %s. The ID should be in the format [id] or [id]/dashboard_json", d.Id())
+	}
+
+	d.SetId(id)
+	// Read only populates `dashboard_json` when it is already set.
+	if err := d.Set("dashboard_json", "[]"); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceFastlyCustomDashboardUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	conn := meta.(*APIClient).conn
 
@@ -312,6 +357,10 @@ func flattenDashboardItems(remoteState []gofastly.DashboardItem) []map[string]in
 }
 
 func resourceItems(d *schema.ResourceData) ([]gofastly.DashboardItem, error) {
+	if v, ok := d.GetOk("dashboard_json"); ok {
+		return parseDashboardJSON(v.(string))
+	}
+
 	var items []gofastly.DashboardItem
 	var errs []error
 	if v, ok := d.GetOk("dashboard_item"); ok {
@@ -456,3 +505,84 @@ func mapToDashboardItem(m map[string]any) (*gofastly.DashboardItem, error) {
 		},
 	}, nil
 }
+
+// parseDashboardJSON parses the items of a dashboard from either a dashboard
+// document (an object with an `items` key) or a list of items. Defaults the
+// API applies are filled in, so that items only differing by omitted defaults
+// are equal.
+func parseDashboardJSON(s string) ([]gofastly.DashboardItem, error) {
+	data := []byte(strings.TrimSpace(s))
+
+	var items []gofastly.DashboardItem
+	if bytes.HasPrefix(data, []byte("{")) {
+		var dash struct {
+			Items *[]gofastly.DashboardItem `json:"items"`
+		}
+		if err := json.Unmarshal(data, &dash); err != nil {
+			return nil, err
+		}
+		if dash.Items == nil {
+			return nil, errors.New("dashboard document has no `items`")
+		}
+		items = *dash.Items
+	} else if err := json.Unmarshal(data, &items); err != nil {
+		return nil, err
+	}
+
+	for i := range items {
+		if items[i].Span == 0 {
+			items[i].Span = 4
+		}
+		if items[i].Visualization.Config.Format == nil {
+			items[i].Visualization.Config.Format = gofastly.ToPointer(gofastly.VisualizationFormatNumber)
+		}
+		if items[i].DataSource.Config.Metrics == nil {
+			items[i].DataSource.Config.Metrics = []string{}
+		}
+	}
+	if items == nil {
+		items = []gofastly.DashboardItem{}
+	}
+	return items, nil
+}
+
+// normaliseDashboardItems renders dashboard items as indented JSON with
+// defaults filled in.
+func normaliseDashboardItems(items []gofastly.DashboardItem) (string, error) {
+	data, err := json.Marshal(items)
+	if err != nil {
+		return "", err
+	}
+	items, err = parseDashboardJSON(string(data))
+	if err != nil {
+		return "", err
+	}
+	data, err = json.MarshalIndent(items, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// dashboardJSONEqual reports whether two dashboard JSON documents describe the
+// same items. Items without an ID in n match the ID of the item at the same
+// position in o, as the API assigns IDs to items created without one.
+func dashboardJSONEqual(o, n string) bool {
+	oldItems, err := parseDashboardJSON(o)
+	if err != nil {
+		return false
+	}
+	newItems, err := parseDashboardJSON(n)
+	if err != nil {
+		return false
+	}
+	if len(oldItems) != len(newItems) {
+		return false
+	}
+	for i := range newItems {
+		if newItems[i].ID == "" {
+			newItems[i].ID = oldItems[i].ID
+		}
+	}
+	return reflect.DeepEqual(oldItems, newItems)
+}
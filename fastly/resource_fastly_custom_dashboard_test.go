@@ -2,6 +2,7 @@ package fastly
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"strings"
 	"testing"
@@ -10,6 +11,7 @@ import (
 	gofastly "github.com/fastly/go-fastly/v9/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -189,6 +191,130 @@ func TestAccFastlyCustomDashboard_Basic(t *testing.T) {
 
 }
 
+func TestDashboardJSONEqual(t *testing.T) {
+	remote := `[
+  {
+    "data_source": {"config": {"metrics": ["requests"]}, "type": "stats.edge"},
+    "id": "abc123",
+    "span": 4,
+    "subtitle": "",
+    "title": "Requests",
+    "visualization": {"config": {"format": "number", "plot_type": "line"}, "type": "chart"}
+  }
+]`
+
+	for name, testcase := range map[string]struct {
+		config string
+		want   bool
+	}{
+		"same": {
+			config: remote,
+			want:   true,
+		},
+		"document with omitted defaults and ID": {
+			config: `{"name": "UI export", "items": [{"title": "Requests", "visualization": {"type": "chart", "config": {"plot_type": "line"}}, "data_source": {"type": "stats.edge", "config": {"metrics": ["requests"]}}}]}`,
+			want:   true,
+		},
+		"different ID": {
+			config: `[{"id": "other", "title": "Requests", "visualization": {"type": "chart", "config": {"plot_type": "line"}}, "data_source": {"type": "stats.edge", "config": {"metrics": ["requests"]}}}]`,
+			want:   false,
+		},
+		"different span": {
+			config: `[{"span": 6, "title": "Requests", "visualization": {"type": "chart", "config": {"plot_type": "line"}}, "data_source": {"type": "stats.edge", "config": {"metrics": ["requests"]}}}]`,
+			want:   false,
+		},
+		"extra item": {
+			config: `[{"title": "Requests", "visualization": {"type": "chart", "config": {"plot_type": "line"}}, "data_source": {"type": "stats.edge", "config": {"metrics": ["requests"]}}}, {"title": "Other"}]`,
+			want:   false,
+		},
+		"invalid": {
+			config: `[`,
+			want:   false,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if got := dashboardJSONEqual(remote, testcase.config); got != testcase.want {
+				t.Errorf("want %t, got %t", testcase.want, got)
+			}
+		})
+	}
+}
+
+func TestAccFastlyCustomDashboard_JSON(t *testing.T) {
+	dashboardName, _, _ := generateDashboardParams(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckCustomDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "fastly_custom_dashboard" "example" {
+  name = "%s"
+  dashboard_json = jsonencode({
+    items = [{
+      title       = "Requests"
+      data_source = { type = "stats.edge", config = { metrics = ["requests"] } }
+      visualization = { type = "chart", config = { plot_type = "line" } }
+    }]
+  })
+}
+
+data "fastly_custom_dashboard" "example" {
+  id = fastly_custom_dashboard.example.id
+}
+`, dashboardName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCustomDashboardRemoteState(dashboardName),
+					resource.TestCheckResourceAttr("data.fastly_custom_dashboard.example", "name", dashboardName),
+					resource.TestCheckResourceAttr("data.fastly_custom_dashboard.example", "dashboard_item.#", "1"),
+					resource.TestCheckResourceAttr("data.fastly_custom_dashboard.example", "dashboard_item.0.title", "Requests"),
+					resource.TestCheckResourceAttr("data.fastly_custom_dashboard.example", "dashboard_item.0.span", "4"),
+					resource.TestCheckResourceAttrPair("data.fastly_custom_dashboard.example", "dashboard_json", "fastly_custom_dashboard.example", "dashboard_json"),
+				),
+			},
+			{
+				ResourceName: "fastly_custom_dashboard.example",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["fastly_custom_dashboard.example"].Primary.ID + "/dashboard_json", nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceFastlyCustomDashboardImport(t *testing.T) {
+	for id, want := range map[string]string{
+		"abc":                "",
+		"abc/dashboard_json": "[]",
+	} {
+		d := schema.TestResourceDataRaw(t, resourceFastlyCustomDashboard().Schema, map[string]any{})
+		d.SetId(id)
+
+		result, err := resourceFastlyCustomDashboardImport(context.Background(), d, nil)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", id, err)
+		}
+		if got := result[0].Id(); got != "abc" {
+			t.Errorf("%s: want ID %q, got %q", id, "abc", got)
+		}
+		if got := result[0].Get("dashboard_json").(string); got != want {
+			t.Errorf("%s: want dashboard_json %q, got %q", id, want, got)
+		}
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceFastlyCustomDashboard().Schema, map[string]any{})
+	d.SetId("abc/items")
+	if _, err := resourceFastlyCustomDashboardImport(context.Background(), d, nil); err == nil {
+		t.Error("expected an error for an unknown suffix")
+	}
+}
+
 func testAccCustomDashboardRemoteState(dashboardName string) resource.TestCheckFunc {
 	return func(_ *terraform.State) error {
 		conn := testAccProvider.Meta().(*APIClient).conn
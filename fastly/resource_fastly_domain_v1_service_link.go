@@ -0,0 +1,262 @@
+package fastly
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	v1 "github.com/fastly/go-fastly/v9/fastly/domains/v1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	domainV1LinkOnDestroyKeep    = "keep"
+	domainV1LinkOnDestroyRestore = "restore"
+	domainV1LinkOnDestroyUnlink  = "unlink"
+)
+
+func resourceFastlyDomainV1ServiceLink() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFastlyDomainV1ServiceLinkCreate,
+		ReadContext:   resourceFastlyDomainV1ServiceLinkRead,
+		UpdateContext: resourceFastlyDomainV1ServiceLinkUpdate,
+		DeleteContext: resourceFastlyDomainV1ServiceLinkDelete,
+
+		Schema: map[string]*schema.Schema{
+			"domain_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "The IDs of the domains to associate with `service_id`.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"on_destroy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          domainV1LinkOnDestroyKeep,
+				Description:      "What happens to the domains when they are removed from `domain_ids` or the resource is destroyed. One of: `keep` (leave them associated with `service_id`), `restore` (associate them with the service they were associated with before) or `unlink` (remove the association). Default `keep`.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{domainV1LinkOnDestroyKeep, domainV1LinkOnDestroyRestore, domainV1LinkOnDestroyUnlink}, false)),
+			},
+			"previous_service_ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "A map of the IDs of the linked domains to the service they were associated with before they were linked (empty if none), used by `on_destroy = \"restore\"`.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"service_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The service to associate the domains with. Changing it moves all the domains to the new service in one operation.",
+			},
+		},
+	}
+}
+
+// domainV1Move is a change of the service a domain is associated with. An
+// empty service removes the association.
+type domainV1Move struct {
+	DomainID string
+	From     string
+	To       string
+}
+
+func resourceFastlyDomainV1ServiceLinkCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	domainIDs := buildStringSlice(d.Get("domain_ids").(*schema.Set))
+	current, err := getDomainV1Services(conn, domainIDs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := applyDomainV1Moves(conn, planDomainV1Moves(current, d.Get("service_id").(string))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.UniqueId())
+	if err := d.Set("previous_service_ids", current); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceFastlyDomainV1ServiceLinkRead(ctx, d, meta)
+}
+
+func resourceFastlyDomainV1ServiceLinkRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	log.Printf("[DEBUG] Refreshing Domain V1 Service Link for (%s)", d.Id())
+	conn := meta.(*APIClient).conn
+
+	serviceID := d.Get("service_id").(string)
+
+	// Domains that are no longer associated with the service are dropped, so
+	// that the next plan moves them back.
+	var linked []string
+	for _, domainID := range buildStringSlice(d.Get("domain_ids").(*schema.Set)) {
+		data, err := v1.Get(conn, &v1.GetInput{DomainID: gofastly.ToPointer(domainID)})
+		if err != nil {
+			if e, ok := err.(*gofastly.HTTPError); ok && e.IsNotFound() {
+				log.Printf("[WARN] Domain (%s) not found, removing from link (%s)", domainID, d.Id())
+				continue
+			}
+			return diag.FromErr(err)
+		}
+		if gofastly.ToValue(data.ServiceID) == serviceID {
+			linked = append(linked, domainID)
+		}
+	}
+
+	if err := d.Set("domain_ids", linked); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceFastlyDomainV1ServiceLinkUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	o, n := d.GetChange("domain_ids")
+	removed := buildStringSlice(o.(*schema.Set).Difference(n.(*schema.Set)))
+	domainIDs := buildStringSlice(n.(*schema.Set))
+
+	current, err := getDomainV1Services(conn, domainIDs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	previous := buildStringMap(d.Get("previous_service_ids").(map[string]any))
+	moves := planDomainV1Moves(current, d.Get("service_id").(string))
+	releases, err := planDomainV1Releases(conn, removed, previous, d.Get("on_destroy").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := applyDomainV1Moves(conn, append(moves, releases...)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The service a domain was associated with before is only recorded when
+	// the domain joins the link.
+	for _, domainID := range removed {
+		delete(previous, domainID)
+	}
+	for _, domainID := range domainIDs {
+		if _, ok := previous[domainID]; !ok {
+			previous[domainID] = current[domainID]
+		}
+	}
+	if err := d.Set("previous_service_ids", previous); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceFastlyDomainV1ServiceLinkRead(ctx, d, meta)
+}
+
+func resourceFastlyDomainV1ServiceLinkDelete(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	releases, err := planDomainV1Releases(
+		conn,
+		buildStringSlice(d.Get("domain_ids").(*schema.Set)),
+		buildStringMap(d.Get("previous_service_ids").(map[string]any)),
+		d.Get("on_destroy").(string),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := applyDomainV1Moves(conn, releases); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// getDomainV1Services returns the service each domain is associated with
+// (empty if none). Every domain is looked up before any is moved, so that a
+// missing domain fails the operation without changing anything.
+func getDomainV1Services(conn *gofastly.Client, domainIDs []string) (map[string]string, error) {
+	result := make(map[string]string, len(domainIDs))
+	for _, domainID := range domainIDs {
+		data, err := v1.Get(conn, &v1.GetInput{DomainID: gofastly.ToPointer(domainID)})
+		if err != nil {
+			return nil, fmt.Errorf("error looking up domain (%s): %w", domainID, err)
+		}
+		result[domainID] = gofastly.ToValue(data.ServiceID)
+	}
+	return result, nil
+}
+
+// planDomainV1Moves returns the moves associating the domains with serviceID,
+// sorted by domain ID. Domains already associated with it are left alone.
+func planDomainV1Moves(current map[string]string, serviceID string) []domainV1Move {
+	var moves []domainV1Move
+	for _, domainID := range sortedKeys(current) {
+		if current[domainID] != serviceID {
+			moves = append(moves, domainV1Move{DomainID: domainID, From: current[domainID], To: serviceID})
+		}
+	}
+	return moves
+}
+
+// planDomainV1Releases returns the moves applying `on_destroy` to domains
+// leaving the link.
+func planDomainV1Releases(conn *gofastly.Client, domainIDs []string, previous map[string]string, onDestroy string) ([]domainV1Move, error) {
+	if onDestroy == domainV1LinkOnDestroyKeep || len(domainIDs) == 0 {
+		return nil, nil
+	}
+
+	current, err := getDomainV1Services(conn, domainIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var moves []domainV1Move
+	for _, domainID := range sortedKeys(current) {
+		to := ""
+		if onDestroy == domainV1LinkOnDestroyRestore {
+			to = previous[domainID]
+		}
+		if current[domainID] != to {
+			moves = append(moves, domainV1Move{DomainID: domainID, From: current[domainID], To: to})
+		}
+	}
+	return moves, nil
+}
+
+// applyDomainV1Moves associates each domain with its new service. If a move
+// fails, the domains already moved are moved back, so that the domains
+// change services together or not at all.
+func applyDomainV1Moves(conn *gofastly.Client, moves []domainV1Move) error {
+	for i, m := range moves {
+		log.Printf("[DEBUG] Moving domain (%s) from service (%s) to (%s)", m.DomainID, m.From, m.To)
+		if err := updateDomainV1Service(conn, m.DomainID, m.To); err != nil {
+			err = fmt.Errorf("error moving domain (%s) to service (%s): %w", m.DomainID, m.To, err)
+
+			var errs []error
+			for _, done := range moves[:i] {
+				if rerr := updateDomainV1Service(conn, done.DomainID, done.From); rerr != nil {
+					errs = append(errs, fmt.Errorf("error moving domain (%s) back to service (%s): %w", done.DomainID, done.From, rerr))
+				}
+			}
+			return errors.Join(append([]error{err}, errs...)...)
+		}
+	}
+	return nil
+}
+
+// updateDomainV1Service associates a domain with a service, or removes the
+// association if serviceID is empty.
+func updateDomainV1Service(conn *gofastly.Client, domainID, serviceID string) error {
+	input := &v1.UpdateInput{
+		DomainID: gofastly.ToPointer(domainID),
+	}
+	if serviceID != "" {
+		input.ServiceID = gofastly.ToPointer(serviceID)
+	}
+	_, err := v1.Update(conn, input)
+	return err
+}
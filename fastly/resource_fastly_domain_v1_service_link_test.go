@@ -0,0 +1,142 @@
+package fastly
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	v1 "github.com/fastly/go-fastly/v9/fastly/domains/v1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestPlanDomainV1Moves(t *testing.T) {
+	current := map[string]string{
+		"c": "blue",
+		"a": "blue",
+		"b": "green",
+		"d": "",
+	}
+
+	got := planDomainV1Moves(current, "green")
+	want := []domainV1Move{
+		{DomainID: "a", From: "blue", To: "green"},
+		{DomainID: "c", From: "blue", To: "green"},
+		{DomainID: "d", From: "", To: "green"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if got := planDomainV1Moves(map[string]string{"a": "green"}, "green"); len(got) != 0 {
+		t.Errorf("expected no moves, got %v", got)
+	}
+}
+
+func TestAccFastlyDomainV1ServiceLink_Basic(t *testing.T) {
+	suffix := acctest.RandString(10)
+	domainNames := []string{
+		fmt.Sprintf("www.fastly-test.tf-%s.com", suffix),
+		fmt.Sprintf("api.fastly-test.tf-%s.com", suffix),
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDomainV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainV1ServiceLinkConfig(suffix, domainNames, "blue"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_domain_v1_service_link.example", "domain_ids.#", "2"),
+					testAccCheckDomainV1ServiceLinked("fastly_service_vcl.blue", "fastly_domain_v1.www", "fastly_domain_v1.api"),
+				),
+			},
+			{
+				Config: testAccDomainV1ServiceLinkConfig(suffix, domainNames, "green"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_domain_v1_service_link.example", "domain_ids.#", "2"),
+					testAccCheckDomainV1ServiceLinked("fastly_service_vcl.green", "fastly_domain_v1.www", "fastly_domain_v1.api"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDomainV1ServiceLinked(serviceName string, domainNames ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		service, ok := s.RootModule().Resources[serviceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", serviceName)
+		}
+
+		conn := testAccProvider.Meta().(*APIClient).conn
+		for _, domainName := range domainNames {
+			domain, ok := s.RootModule().Resources[domainName]
+			if !ok {
+				return fmt.Errorf("not found: %s", domainName)
+			}
+			domainID := domain.Primary.Attributes["domain_id"]
+			data, err := v1.Get(conn, &v1.GetInput{DomainID: gofastly.ToPointer(domainID)})
+			if err != nil {
+				return err
+			}
+			if got := gofastly.ToValue(data.ServiceID); got != service.Primary.ID {
+				return fmt.Errorf("domain (%s) is associated with service (%s), expected (%s)", domainID, got, service.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccDomainV1ServiceLinkConfig(suffix string, domainNames []string, target string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_vcl" "blue" {
+  name = "tf-blue-%[1]s"
+  domain {
+    name = "%[2]s"
+  }
+  domain {
+    name = "%[3]s"
+  }
+  force_destroy = true
+}
+
+resource "fastly_service_vcl" "green" {
+  name = "tf-green-%[1]s"
+  domain {
+    name = "%[2]s"
+  }
+  domain {
+    name = "%[3]s"
+  }
+  force_destroy = true
+}
+
+resource "fastly_domain_v1" "www" {
+  fqdn = "%[2]s"
+
+  lifecycle {
+    ignore_changes = [service_id]
+  }
+}
+
+resource "fastly_domain_v1" "api" {
+  fqdn = "%[3]s"
+
+  lifecycle {
+    ignore_changes = [service_id]
+  }
+}
+
+resource "fastly_domain_v1_service_link" "example" {
+  service_id = fastly_service_vcl.%[4]s.id
+  domain_ids = [fastly_domain_v1.www.domain_id, fastly_domain_v1.api.domain_id]
+  on_destroy = "unlink"
+}
+`, suffix, domainNames[0], domainNames[1], target)
+}
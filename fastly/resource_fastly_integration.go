@@ -2,6 +2,7 @@ package fastly
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	gofastly "github.com/fastly/go-fastly/v9/fastly"
@@ -10,7 +11,95 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// integrationConfigField maps an attribute of a typed integration block to a
+// key of the integration's `config`.
+type integrationConfigField struct {
+	Attribute   string
+	Description string
+	Key         string
+	Sensitive   bool
+}
+
+// integrationConfigBlocks are the typed configuration blocks, one per
+// integration type, as an alternative to the untyped `config` map.
+var integrationConfigBlocks = map[string][]integrationConfigField{
+	"mailinglist": {
+		{Attribute: "address", Key: "address", Description: "The mailing list address."},
+	},
+	"microsoftteams": {
+		{Attribute: "webhook_url", Key: "webhook", Sensitive: true, Description: "The Microsoft Teams incoming webhook URL."},
+	},
+	"newrelic": {
+		{Attribute: "account_id", Key: "account", Description: "The New Relic account ID."},
+		{Attribute: "license_key", Key: "key", Sensitive: true, Description: "The New Relic license key."},
+	},
+	"pagerduty": {
+		{Attribute: "integration_key", Key: "key", Sensitive: true, Description: "The PagerDuty integration key."},
+	},
+	"slack": {
+		{Attribute: "webhook_url", Key: "webhook", Sensitive: true, Description: "The Slack incoming webhook URL."},
+	},
+	"webhook": {
+		{Attribute: "url", Key: "webhook", Description: "The URL notifications are posted to. Use `fastly_integration_webhook_signing_key` to verify the payloads."},
+	},
+}
+
+// integrationConfigKeys are the attributes exactly one of which configures an
+// integration.
+var integrationConfigKeys = append([]string{"config"}, sortedKeys(integrationConfigBlocks)...)
+
 func resourceFastlyIntegration() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"config": {
+			Type:         schema.TypeMap,
+			Optional:     true,
+			Description:  "Configuration specific to the integration `type` (see documentation examples). Consider the typed block for the `type` instead, which only hides secrets from plans.",
+			Elem:         schema.TypeString,
+			Sensitive:    true,
+			ExactlyOneOf: integrationConfigKeys,
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "User submitted description of the integration.",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "User submitted name of the integration.",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Type of the integration. One of: `mailinglist`, `microsoftteams`, `newrelic`, `pagerduty`, `slack`, `webhook`.",
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(
+				[]string{"mailinglist", "microsoftteams", "newrelic", "pagerduty", "slack", "webhook"},
+				false,
+			)),
+		},
+	}
+
+	for integrationType, fields := range integrationConfigBlocks {
+		block := map[string]*schema.Schema{}
+		for _, f := range fields {
+			block[f.Attribute] = &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      f.Description,
+				Sensitive:        f.Sensitive,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			}
+		}
+		s[integrationType] = &schema.Schema{
+			Type:         schema.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			Description:  fmt.Sprintf("Configuration of a `%s` integration.", integrationType),
+			ExactlyOneOf: integrationConfigKeys,
+			Elem:         &schema.Resource{Schema: block},
+		}
+	}
+
 	return &schema.Resource{
 		CreateContext: resourceFastlyIntegrationCreate,
 		ReadContext:   resourceFastlyIntegrationRead,
@@ -19,43 +108,81 @@ func resourceFastlyIntegration() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: validateIntegrationConfigBlock,
+		Schema:        s,
+	}
+}
 
-		Schema: map[string]*schema.Schema{
-			"config": {
-				Type:        schema.TypeMap,
-				Required:    true,
-				Description: "Configuration specific to the integration `type` (see documentation examples).",
-				Elem:        schema.TypeString,
-				Sensitive:   true,
-			},
-			"description": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "User submitted description of the integration.",
-			},
-			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "User submitted name of the integration.",
-			},
-			"type": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Type of the integration. One of: `mailinglist`, `microsoftteams`, `newrelic`, `pagerduty`, `slack`, `webhook`.",
-				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(
-					[]string{"mailinglist", "microsoftteams", "newrelic", "pagerduty", "slack", "webhook"},
-					false,
-				)),
-			},
-		},
+// validateIntegrationConfigBlock rejects a typed configuration block that does
+// not match the integration `type`.
+func validateIntegrationConfigBlock(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.NewValueKnown("type") {
+		return nil
 	}
+	integrationType := d.Get("type").(string)
+	for _, block := range sortedKeys(integrationConfigBlocks) {
+		if block != integrationType && len(d.Get(block).([]any)) > 0 {
+			return fmt.Errorf("a `%s` block cannot configure an integration of type %q, use a `%s` block instead", block, integrationType, integrationType)
+		}
+	}
+	return nil
+}
+
+// expandIntegrationConfig returns the integration's `config`, built from the
+// typed configuration block if one is used.
+func expandIntegrationConfig(d *schema.ResourceData) map[string]string {
+	for integrationType, fields := range integrationConfigBlocks {
+		v := d.Get(integrationType).([]any)
+		if len(v) == 0 || v[0] == nil {
+			continue
+		}
+		block := v[0].(map[string]any)
+		config := map[string]string{}
+		for _, f := range fields {
+			config[f.Key] = block[f.Attribute].(string)
+		}
+		return config
+	}
+	return castToMapString(d.Get("config").(map[string]any))
+}
+
+// flattenIntegrationConfig models the `config` of an integration as its typed
+// configuration block. Secrets may be redacted by the API, so the secrets
+// in prior are kept where set.
+func flattenIntegrationConfig(integrationType string, config map[string]string, prior []any) []map[string]any {
+	var priorBlock map[string]any
+	if len(prior) > 0 && prior[0] != nil {
+		priorBlock = prior[0].(map[string]any)
+	}
+
+	block := map[string]any{}
+	for _, f := range integrationConfigBlocks[integrationType] {
+		block[f.Attribute] = config[f.Key]
+		if f.Sensitive && priorBlock != nil {
+			if v, ok := priorBlock[f.Attribute].(string); ok && v != "" {
+				block[f.Attribute] = v
+			}
+		}
+	}
+	return []map[string]any{block}
+}
+
+// integrationConfigBlockInUse returns the typed configuration block the
+// integration is configured with, or "" if `config` is used.
+func integrationConfigBlockInUse(d *schema.ResourceData) string {
+	for _, block := range sortedKeys(integrationConfigBlocks) {
+		if len(d.Get(block).([]any)) > 0 {
+			return block
+		}
+	}
+	return ""
 }
 
 func resourceFastlyIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	conn := meta.(*APIClient).conn
 
 	input := gofastly.CreateIntegrationInput{
-		Config: castToMapString(d.Get("config").(map[string]any)),
+		Config: expandIntegrationConfig(d),
 		Name:   gofastly.ToPointer(d.Get("name").(string)),
 		Type:   gofastly.ToPointer(d.Get("type").(string)),
 	}
@@ -85,7 +212,12 @@ func resourceFastlyIntegrationRead(_ context.Context, d *schema.ResourceData, me
 		return diag.FromErr(err)
 	}
 
-	if i.Config != nil {
+	if block := integrationConfigBlockInUse(d); block != "" {
+		err = d.Set(block, flattenIntegrationConfig(block, i.Config, d.Get(block).([]any)))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else if i.Config != nil {
 		err = d.Set("config", i.Config)
 		if err != nil {
 			return diag.FromErr(err)
@@ -123,7 +255,7 @@ func resourceFastlyIntegrationUpdate(ctx context.Context, d *schema.ResourceData
 	conn := meta.(*APIClient).conn
 
 	input := gofastly.UpdateIntegrationInput{
-		Config: castToMapString(d.Get("config").(map[string]any)),
+		Config: expandIntegrationConfig(d),
 		ID:     d.Id(),
 		Name:   gofastly.ToPointer(d.Get("name").(string)),
 		Type:   gofastly.ToPointer(d.Get("type").(string)),
@@ -2,12 +2,14 @@ package fastly
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	gofastly "github.com/fastly/go-fastly/v9/fastly"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -206,6 +208,89 @@ func testAccCheckFastlyIntegrationsRemoteState(expected gofastly.Integration) re
 	}
 }
 
+func TestFlattenIntegrationConfig(t *testing.T) {
+	config := map[string]string{"account": "12345", "key": "****"}
+
+	got := flattenIntegrationConfig("newrelic", config, nil)
+	want := []map[string]any{{"account_id": "12345", "license_key": "****"}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected config -want +got\n%v", diff)
+	}
+
+	// Secrets in the prior state are kept, other values are read back.
+	prior := []any{map[string]any{"account_id": "old", "license_key": "secret"}}
+	got = flattenIntegrationConfig("newrelic", config, prior)
+	want = []map[string]any{{"account_id": "12345", "license_key": "secret"}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected config -want +got\n%v", diff)
+	}
+}
+
+func TestIntegrationConfigBlocks(t *testing.T) {
+	s := resourceFastlyIntegration().Schema
+	for _, integrationType := range []string{"mailinglist", "microsoftteams", "newrelic", "pagerduty", "slack", "webhook"} {
+		if _, ok := s[integrationType]; !ok {
+			t.Errorf("missing %s block", integrationType)
+		}
+	}
+	if !s["slack"].Elem.(*schema.Resource).Schema["webhook_url"].Sensitive {
+		t.Errorf("expected slack webhook_url to be sensitive")
+	}
+	if s["mailinglist"].Elem.(*schema.Resource).Schema["address"].Sensitive {
+		t.Errorf("expected mailinglist address not to be sensitive")
+	}
+}
+
+func TestAccFastlyIntegration_typedBlock(t *testing.T) {
+	name := fmt.Sprintf("integration %s", acctest.RandString(10))
+	config := func(webhook string) string {
+		return fmt.Sprintf(`
+resource "fastly_integration" "foo" {
+  name = "%s"
+  type = "slack"
+
+  slack {
+    webhook_url = "%s"
+  }
+}`, name, webhook)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config("https://hooks.slack.com/services/T00000000/B00000000/" + acctest.RandString(24)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_integration.foo", "slack.#", "1"),
+					resource.TestCheckNoResourceAttr("fastly_integration.foo", "config.%"),
+				),
+			},
+			{
+				Config: config("https://hooks.slack.com/services/T00000000/B00000000/" + acctest.RandString(24)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_integration.foo", "slack.#", "1"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "fastly_integration" "foo" {
+  name = "%s"
+  type = "slack"
+
+  pagerduty {
+    integration_key = "abc"
+  }
+}`, name),
+				ExpectError: regexp.MustCompile("use a `slack` block instead"),
+			},
+		},
+	})
+}
+
 func testAccCheckIntegrationDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "fastly_integration" {
@@ -0,0 +1,130 @@
+package fastly
+
+import (
+	"context"
+	"log"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceFastlyIntegrationWebhookSigningKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFastlyIntegrationWebhookSigningKeyCreate,
+		ReadContext:   resourceFastlyIntegrationWebhookSigningKeyRead,
+		UpdateContext: resourceFastlyIntegrationWebhookSigningKeyUpdate,
+		DeleteContext: resourceFastlyIntegrationWebhookSigningKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceFastlyIntegrationWebhookSigningKeyImport,
+		},
+		// A change to the triggers rotates the key, so the new key is only
+		// known after apply.
+		CustomizeDiff: customdiff.ComputedIf("signing_key", func(_ context.Context, d *schema.ResourceDiff, _ any) bool {
+			return d.HasChange("triggers")
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"integration_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the `webhook` integration.",
+			},
+			"rotate_on_create": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to rotate the signing key when the resource is created, instead of using the current key. Default `false`.",
+			},
+			"signing_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The key used to sign the payloads of the webhook.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Arbitrary values which rotate the signing key when changed, e.g. a timestamp from a `time_rotating` resource.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceFastlyIntegrationWebhookSigningKeyCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	integrationID := d.Get("integration_id").(string)
+
+	if d.Get("rotate_on_create").(bool) {
+		if err := rotateWebhookSigningKey(meta.(*APIClient).conn, integrationID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(integrationID)
+
+	return resourceFastlyIntegrationWebhookSigningKeyRead(ctx, d, meta)
+}
+
+func resourceFastlyIntegrationWebhookSigningKeyRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	log.Printf("[DEBUG] Refreshing Webhook Signing Key for Integration (%s)", d.Id())
+	conn := meta.(*APIClient).conn
+
+	key, err := conn.GetWebhookSigningKey(&gofastly.GetWebhookSigningKeyInput{
+		IntegrationID: d.Id(),
+	})
+	if err != nil {
+		if e, ok := err.(*gofastly.HTTPError); ok && e.IsNotFound() && !d.IsNewResource() {
+			log.Printf("[WARN] Integration (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("integration_id", d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("signing_key", gofastly.ToValue(key.SigningKey))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceFastlyIntegrationWebhookSigningKeyUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if d.HasChange("triggers") {
+		if err := rotateWebhookSigningKey(meta.(*APIClient).conn, d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceFastlyIntegrationWebhookSigningKeyRead(ctx, d, meta)
+}
+
+// resourceFastlyIntegrationWebhookSigningKeyDelete only removes the key from
+// the state: a webhook integration always has a signing key.
+func resourceFastlyIntegrationWebhookSigningKeyDelete(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+func resourceFastlyIntegrationWebhookSigningKeyImport(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+	if err := d.Set("rotate_on_create", false); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func rotateWebhookSigningKey(conn *gofastly.Client, integrationID string) error {
+	log.Printf("[DEBUG] Rotating Webhook Signing Key for Integration (%s)", integrationID)
+
+	_, err := conn.RotateWebhookSigningKey(&gofastly.RotateWebhookSigningKeyInput{
+		IntegrationID: integrationID,
+	})
+	return err
+}
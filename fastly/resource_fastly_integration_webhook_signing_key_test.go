@@ -0,0 +1,100 @@
+package fastly
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceFastlyIntegrationWebhookSigningKeyDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "integration",
+		Attributes: map[string]string{
+			"id":                "integration",
+			"integration_id":    "integration",
+			"rotate_on_create":  "false",
+			"signing_key":       "key",
+			"triggers.%":        "1",
+			"triggers.rotation": "1",
+		},
+	}
+
+	for rotation, want := range map[string]bool{"1": false, "2": true} {
+		diff, err := resourceFastlyIntegrationWebhookSigningKey().Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]any{
+			"integration_id": "integration",
+			"triggers":       map[string]any{"rotation": rotation},
+		}), nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		got := diff != nil && diff.Attributes["signing_key"] != nil && diff.Attributes["signing_key"].NewComputed
+		if got != want {
+			t.Errorf("rotation %s: want signing_key computed %t, got %t", rotation, want, got)
+		}
+	}
+}
+
+func TestAccFastlyIntegrationWebhookSigningKey_rotation(t *testing.T) {
+	name := fmt.Sprintf("integration %s", acctest.RandString(10))
+	var first string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationWebhookSigningKeyConfig(name, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("fastly_integration_webhook_signing_key.foo", "signing_key"),
+					resource.TestCheckResourceAttrPair("fastly_integration_webhook_signing_key.foo", "integration_id", "fastly_integration.foo", "id"),
+					func(s *terraform.State) error {
+						first = s.RootModule().Resources["fastly_integration_webhook_signing_key.foo"].Primary.Attributes["signing_key"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccIntegrationWebhookSigningKeyConfig(name, "2"),
+				Check: func(s *terraform.State) error {
+					if got := s.RootModule().Resources["fastly_integration_webhook_signing_key.foo"].Primary.Attributes["signing_key"]; got == first {
+						return fmt.Errorf("expected the signing key to be rotated")
+					}
+					return nil
+				},
+			},
+			{
+				ResourceName:            "fastly_integration_webhook_signing_key.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"triggers"},
+			},
+		},
+	})
+}
+
+func testAccIntegrationWebhookSigningKeyConfig(name, rotation string) string {
+	return fmt.Sprintf(`
+resource "fastly_integration" "foo" {
+  name = "%s"
+  type = "webhook"
+
+  webhook {
+    url = "https://example.com/webhook"
+  }
+}
+
+resource "fastly_integration_webhook_signing_key" "foo" {
+  integration_id = fastly_integration.foo.id
+
+  triggers = {
+    rotation = "%s"
+  }
+}`, name, rotation)
+}
@@ -0,0 +1,189 @@
+package fastly
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// purgeKeysMaximumBatchSize is the maximum number of surrogate keys the
+// Fastly API accepts in a single batch purge request.
+const purgeKeysMaximumBatchSize = 256
+
+func resourceFastlyPurge() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFastlyPurgeCreate,
+		ReadContext:   resourceFastlyPurgeRead,
+		DeleteContext: resourceFastlyPurgeDelete,
+		Schema: map[string]*schema.Schema{
+			"purge_all": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "Purge all cached content for the service. Conflicts with `surrogate_keys`, `urls` and `soft`.",
+				ConflictsWith: []string{"surrogate_keys", "urls", "soft"},
+				AtLeastOneOf:  []string{"purge_all", "surrogate_keys", "urls"},
+			},
+			"purge_ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "A map of each purged surrogate key or URL (or `all` when `purge_all` is set) to the ID of the purge request returned by the Fastly API.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"service_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the service to purge.",
+			},
+			"soft": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Mark the content as stale instead of removing it from the cache. Not supported with `purge_all`. Default `false`.",
+			},
+			"surrogate_keys": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("Surrogate keys to purge. Keys are sent to the Fastly API in batches of %d.", purgeKeysMaximumBatchSize),
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary map of values that, when changed, will trigger the purge to run again (e.g. the `active_version` of a service).",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"urls": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Fully qualified URLs to purge (e.g. `https://www.example.com/index.html`).",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceFastlyPurgeCreate(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	serviceID := d.Get("service_id").(string)
+	soft := d.Get("soft").(bool)
+	purgeIDs := make(map[string]string)
+
+	if d.Get("purge_all").(bool) {
+		input := &gofastly.PurgeAllInput{
+			ServiceID: serviceID,
+		}
+
+		log.Printf("[DEBUG] CREATE: Purge All input: %#v", input)
+
+		purge, err := conn.PurgeAll(input)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		purgeIDs["all"] = gofastly.ToValue(purge.PurgeID)
+	}
+
+	if v, ok := d.GetOk("surrogate_keys"); ok {
+		ids, err := purgeSurrogateKeys(conn, serviceID, buildStringSlice(v.(*schema.Set)), soft)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for k, v := range ids {
+			purgeIDs[k] = v
+		}
+	}
+
+	if v, ok := d.GetOk("urls"); ok {
+		for _, url := range buildStringSlice(v.(*schema.Set)) {
+			input := &gofastly.PurgeInput{
+				URL:  url,
+				Soft: soft,
+			}
+
+			log.Printf("[DEBUG] CREATE: Purge URL input: %#v", input)
+
+			purge, err := conn.Purge(input)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error purging URL (%s): %w", url, err))
+			}
+			purgeIDs[url] = gofastly.ToValue(purge.PurgeID)
+		}
+	}
+
+	d.SetId(id.UniqueId())
+
+	if err := d.Set("purge_ids", purgeIDs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// NOTE: A purge is an action, not an object, so there is nothing to refresh.
+func resourceFastlyPurgeRead(_ context.Context, _ *schema.ResourceData, _ any) diag.Diagnostics {
+	return nil
+}
+
+// NOTE: A purge cannot be undone, so destroying only removes it from state.
+func resourceFastlyPurgeDelete(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// purgeSurrogateKeys purges the given surrogate keys from a service, batching
+// the keys into the maximum number the API accepts per request. It returns a
+// map of surrogate key to purge ID.
+func purgeSurrogateKeys(conn *gofastly.Client, serviceID string, keys []string, soft bool) (map[string]string, error) {
+	purgeIDs := make(map[string]string, len(keys))
+
+	purged := 0
+	for _, batch := range purgeKeyBatches(keys) {
+		input := &gofastly.PurgeKeysInput{
+			ServiceID: serviceID,
+			Keys:      batch,
+			Soft:      soft,
+		}
+
+		log.Printf("[DEBUG] Purge Keys input: %#v", input)
+
+		ids, err := conn.PurgeKeys(input)
+		if err != nil {
+			return nil, fmt.Errorf("error purging surrogate keys %d to %d of %d: %w", purged+1, purged+len(batch), len(keys), err)
+		}
+		for k, v := range ids {
+			purgeIDs[k] = v
+		}
+		purged += len(batch)
+	}
+
+	return purgeIDs, nil
+}
+
+// purgeKeyBatches splits surrogate keys into batches of at most
+// purgeKeysMaximumBatchSize keys, in order.
+func purgeKeyBatches(keys []string) [][]string {
+	var batches [][]string
+	for i := 0; i < len(keys); i += purgeKeysMaximumBatchSize {
+		batches = append(batches, keys[i:min(i+purgeKeysMaximumBatchSize, len(keys))])
+	}
+	return batches
+}
@@ -0,0 +1,141 @@
+package fastly
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestPurgeKeyBatches(t *testing.T) {
+	keys := func(n int) []string {
+		result := make([]string, n)
+		for i := range result {
+			result[i] = strconv.Itoa(i)
+		}
+		return result
+	}
+
+	for _, tc := range []struct {
+		keys int
+		want []int
+	}{
+		{keys: 0, want: nil},
+		{keys: 1, want: []int{1}},
+		{keys: purgeKeysMaximumBatchSize, want: []int{256}},
+		{keys: purgeKeysMaximumBatchSize + 1, want: []int{256, 1}},
+		{keys: 2*purgeKeysMaximumBatchSize + 10, want: []int{256, 256, 10}},
+	} {
+		t.Run(strconv.Itoa(tc.keys), func(t *testing.T) {
+			input := keys(tc.keys)
+			batches := purgeKeyBatches(input)
+
+			var sizes []int
+			var flattened []string
+			for _, b := range batches {
+				sizes = append(sizes, len(b))
+				flattened = append(flattened, b...)
+			}
+			if fmt.Sprint(sizes) != fmt.Sprint(tc.want) {
+				t.Errorf("got batch sizes %v, want %v", sizes, tc.want)
+			}
+			if fmt.Sprint(flattened) != fmt.Sprint(input) {
+				t.Error("the batches do not hold the keys in order")
+			}
+		})
+	}
+}
+
+func TestAccFastlyPurge_basic(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckServiceVCLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPurgeConfig(serviceName, domainName, "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_purge.keys", "purge_ids.%", "2"),
+					resource.TestCheckResourceAttrSet("fastly_purge.keys", "purge_ids.foo"),
+					resource.TestCheckResourceAttrSet("fastly_purge.all", "purge_ids.all"),
+				),
+			},
+			{
+				// Changing the triggers must replace the resource, which performs the purge again.
+				Config: testAccPurgeConfig(serviceName, domainName, "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_purge.keys", "triggers.release", "v2"),
+					resource.TestCheckResourceAttr("fastly_purge.keys", "purge_ids.%", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFastlyPurge_conflicts(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "fastly_purge" "invalid" {
+  service_id     = "abc"
+  purge_all      = true
+  surrogate_keys = ["foo"]
+}
+`,
+				ExpectError: regexp.MustCompile(`conflicts with`),
+			},
+		},
+	})
+}
+
+func testAccPurgeConfig(serviceName, domainName, release string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_vcl" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  backend {
+    address = "aws.amazon.com"
+    name    = "amazon docs"
+  }
+
+  force_destroy = true
+}
+
+resource "fastly_purge" "keys" {
+  service_id     = fastly_service_vcl.foo.id
+  surrogate_keys = ["foo", "bar"]
+  soft           = true
+
+  triggers = {
+    release = "%s"
+  }
+}
+
+resource "fastly_purge" "all" {
+  service_id = fastly_service_vcl.foo.id
+  purge_all  = true
+
+  triggers = {
+    release = "%s"
+  }
+}
+`, serviceName, domainName, release, release)
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/netip"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -21,6 +24,7 @@ func resourceServiceACLEntries() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceServiceACLEntriesImport,
 		},
+		CustomizeDiff: resourceServiceACLEntriesCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"acl_id": {
 				Type:        schema.TypeString,
@@ -28,13 +32,37 @@ func resourceServiceACLEntries() *schema.Resource {
 				ForceNew:    true,
 				Description: "The ID of the ACL that the items belong to",
 			},
-			"entry": {
-				Type:        schema.TypeSet,
+			"aggregated_cidrs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The entries in the ACL when `cidrs` is used, as the normalised and aggregated list of CIDRs (negated entries are prefixed with `!`)",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"cidrs": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Description:   "A list of IPv4 and IPv6 addresses or CIDRs (prefix with `!` to negate), as an alternative to `entry` blocks for large lists. The list is normalised, deduplicated and aggregated into the smallest set of ACL entries (e.g. `192.0.2.0/25` and `192.0.2.128/25` become `192.0.2.0/24`). Conflicts with `entry`",
+				ConflictsWith: []string{"entry"},
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateACLCIDR(),
+				},
+			},
+			"comment_tag": {
+				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "ACL Entries",
-				MaxItems:    gofastly.MaximumACLSize,
+				Description: "Only manage the entries whose comment starts with this tag, so that several teams can share an ACL. The tag is added to the comment of every entry written by Terraform (as `<tag> <comment>`, or just `<tag>` if the comment is empty) and stripped again when the entries are read. Entries without the tag are ignored (never read, diffed or deleted), entries with it are always managed (as if `manage_entries` was `true`). The tag can be changed (or added after an import) without replacing the resource",
+			},
+			"entry": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Description:   "ACL Entries. Conflicts with `cidrs`",
+				MaxItems:      gofastly.MaximumACLSize,
+				ConflictsWith: []string{"cidrs"},
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					return !d.HasChange("acl_id") && !d.Get("manage_entries").(bool)
+					return !d.HasChange("acl_id") && !d.Get("manage_entries").(bool) && d.Get("comment_tag").(string) == ""
 				},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -89,16 +117,27 @@ func resourceServiceACLEntriesCreate(ctx context.Context, d *schema.ResourceData
 	serviceID := d.Get("service_id").(string)
 	aclID := d.Get("acl_id").(string)
 	entries := d.Get("entry").(*schema.Set)
+	tag := d.Get("comment_tag").(string)
 
 	batchACLEntries := []*gofastly.BatchACLEntry{}
 
 	for _, vRaw := range entries.List() {
 		val := vRaw.(map[string]any)
 
-		entry := buildBatchACLEntry(val, gofastly.CreateBatchOperation)
+		entry := buildBatchACLEntry(val, gofastly.CreateBatchOperation, tag)
 		batchACLEntries = append(batchACLEntries, entry)
 	}
 
+	if v := d.Get("cidrs").(*schema.Set); v.Len() > 0 {
+		desired, err := aggregateACLCIDRs(buildStringSlice(v))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, c := range desired {
+			batchACLEntries = append(batchACLEntries, c.batchEntry(gofastly.CreateBatchOperation, tag))
+		}
+	}
+
 	// Process the batch operations
 	err := executeBatchACLOperations(conn, serviceID, aclID, batchACLEntries)
 	if err != nil {
@@ -125,10 +164,30 @@ func resourceServiceACLEntriesRead(_ context.Context, d *schema.ResourceData, me
 		return diag.FromErr(err)
 	}
 
+	if tag := d.Get("comment_tag").(string); tag != "" {
+		remoteState = filterTaggedACLEntries(remoteState, tag)
+	}
+
+	// When the entries are managed as a list of CIDRs they are only
+	// exposed through `aggregated_cidrs`.
+	if d.Get("cidrs").(*schema.Set).Len() > 0 {
+		if err := d.Set("entry", nil); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("aggregated_cidrs", flattenACLCIDRs(remoteState)); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+
 	err = d.Set("entry", flattenACLEntries(remoteState))
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	err = d.Set("aggregated_cidrs", nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
@@ -138,10 +197,28 @@ func resourceServiceACLEntriesUpdate(ctx context.Context, d *schema.ResourceData
 
 	serviceID := d.Get("service_id").(string)
 	aclID := d.Get("acl_id").(string)
+	tag := d.Get("comment_tag").(string)
 
 	batchACLEntries := []*gofastly.BatchACLEntry{}
 
-	if d.HasChange("entry") {
+	// The entries Terraform owned are moved to the new tag first, so that
+	// they are found with it by the synchronisation below and the next
+	// refresh.
+	if d.HasChange("comment_tag") {
+		if err := retagACLEntries(conn, d); err != nil {
+			return diag.Errorf("error updating ACL entries: service %s, ACL %s, %s", serviceID, aclID, err)
+		}
+	}
+
+	// Changes to `cidrs` (including switching between `cidrs` and `entry`)
+	// are synchronised first, against the entries currently in the ACL.
+	if d.HasChanges("cidrs", "aggregated_cidrs") {
+		if err := syncACLCIDRs(conn, d); err != nil {
+			return diag.Errorf("error updating ACL entries: service %s, ACL %s, %s", serviceID, aclID, err)
+		}
+	}
+
+	if d.HasChange("entry") && d.Get("cidrs").(*schema.Set).Len() == 0 {
 		oe, ne := d.GetChange("entry")
 
 		if oe == nil {
@@ -154,6 +231,23 @@ func resourceServiceACLEntriesUpdate(ctx context.Context, d *schema.ResourceData
 		oldSet := oe.(*schema.Set)
 		newSet := ne.(*schema.Set)
 
+		// The state holds the entries read with the previous tag, which may
+		// include entries of other teams: the entries with the new tag are
+		// read again instead.
+		if tag != "" && d.HasChange("comment_tag") {
+			remoteState, err := getAllAclEntriesViaPaginator(conn, &gofastly.GetACLEntriesInput{
+				ServiceID: serviceID,
+				ACLID:     aclID,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			oldSet = schema.NewSet(oldSet.F, nil)
+			for _, e := range flattenACLEntries(filterTaggedACLEntries(remoteState, tag)) {
+				oldSet.Add(e)
+			}
+		}
+
 		setDiff := NewSetDiff(func(resource any) (any, error) {
 			t, ok := resource.(map[string]any)
 			if !ok {
@@ -181,7 +275,7 @@ func resourceServiceACLEntriesUpdate(ctx context.Context, d *schema.ResourceData
 		for _, resource := range diffResult.Added {
 			resource := resource.(map[string]any)
 
-			entry := buildBatchACLEntry(resource, gofastly.CreateBatchOperation)
+			entry := buildBatchACLEntry(resource, gofastly.CreateBatchOperation, tag)
 			batchACLEntries = append(batchACLEntries, entry)
 		}
 
@@ -189,7 +283,7 @@ func resourceServiceACLEntriesUpdate(ctx context.Context, d *schema.ResourceData
 		for _, resource := range diffResult.Modified {
 			resource := resource.(map[string]any)
 
-			entry := buildBatchACLEntry(resource, gofastly.UpdateBatchOperation)
+			entry := buildBatchACLEntry(resource, gofastly.UpdateBatchOperation, tag)
 			batchACLEntries = append(batchACLEntries, entry)
 		}
 	}
@@ -209,16 +303,28 @@ func resourceServiceACLEntriesDelete(_ context.Context, d *schema.ResourceData,
 	serviceID := d.Get("service_id").(string)
 	aclID := d.Get("acl_id").(string)
 	entries := d.Get("entry").(*schema.Set)
+	tag := d.Get("comment_tag").(string)
 
-	batchACLEntries := []*gofastly.BatchACLEntry{}
+	var tagged []*gofastly.ACLEntry
+	if tag != "" && entries.Len() > 0 {
+		remoteState, err := getAllAclEntriesViaPaginator(conn, &gofastly.GetACLEntriesInput{
+			ServiceID: serviceID,
+			ACLID:     aclID,
+		})
+		if err != nil {
+			return diag.Errorf("error deleting ACL entries: service %s, ACL %s, %s", serviceID, aclID, err)
+		}
+		tagged = filterTaggedACLEntries(remoteState, tag)
+	}
 
-	for _, vRaw := range entries.List() {
-		val := vRaw.(map[string]any)
+	batchACLEntries := buildACLEntryDeletes(entries.List(), tag != "", tagged)
 
-		batchACLEntries = append(batchACLEntries, &gofastly.BatchACLEntry{
-			Operation: gofastly.ToPointer(gofastly.DeleteBatchOperation),
-			EntryID:   gofastly.ToPointer(val["id"].(string)),
-		})
+	if cidrs := d.Get("cidrs").(*schema.Set); cidrs.Len() > 0 {
+		deletes, err := buildACLCIDRDeletes(conn, d, buildStringSlice(cidrs))
+		if err != nil {
+			return diag.Errorf("error deleting ACL entries: service %s, ACL %s, %s", serviceID, aclID, err)
+		}
+		batchACLEntries = append(batchACLEntries, deletes...)
 	}
 
 	// Process the batch operations
@@ -231,6 +337,29 @@ func resourceServiceACLEntriesDelete(_ context.Context, d *schema.ResourceData,
 	return nil
 }
 
+// buildACLEntryDeletes builds the batch operations deleting the entries. If
+// filtered is set, only the entries among the tagged remote entries are
+// deleted, so that entries of other teams the state may hold are left alone.
+func buildACLEntryDeletes(entries []any, filtered bool, tagged []*gofastly.ACLEntry) []*gofastly.BatchACLEntry {
+	ids := make(map[string]bool, len(tagged))
+	for _, e := range tagged {
+		ids[gofastly.ToValue(e.EntryID)] = true
+	}
+
+	batchACLEntries := []*gofastly.BatchACLEntry{}
+	for _, vRaw := range entries {
+		id := vRaw.(map[string]any)["id"].(string)
+		if filtered && !ids[id] {
+			continue
+		}
+		batchACLEntries = append(batchACLEntries, &gofastly.BatchACLEntry{
+			Operation: gofastly.ToPointer(gofastly.DeleteBatchOperation),
+			EntryID:   gofastly.ToPointer(id),
+		})
+	}
+	return batchACLEntries
+}
+
 // flattenACLEntries models data into format suitable for saving to Terraform state.
 func flattenACLEntries(remoteState []*gofastly.ACLEntry) []map[string]any {
 	var result []map[string]any
@@ -311,13 +440,20 @@ func executeBatchACLOperations(conn *gofastly.Client, serviceID, aclID string, b
 	return nil
 }
 
-func buildBatchACLEntry(v map[string]any, op gofastly.BatchOperation) *gofastly.BatchACLEntry {
+// buildBatchACLEntry builds a batch operation for an entry. If tag is set, it
+// is added to the comment of the entry (see filterTaggedACLEntries).
+func buildBatchACLEntry(v map[string]any, op gofastly.BatchOperation, tag string) *gofastly.BatchACLEntry {
+	comment := v["comment"].(string)
+	if tag != "" {
+		comment = strings.TrimSpace(tag + " " + comment)
+	}
+
 	entry := &gofastly.BatchACLEntry{
 		Operation: gofastly.ToPointer(op),
 		EntryID:   gofastly.ToPointer(v["id"].(string)),
 		IP:        gofastly.ToPointer(v["ip"].(string)),
 		Negated:   gofastly.ToPointer(gofastly.Compatibool(v["negated"].(bool))),
-		Comment:   gofastly.ToPointer(v["comment"].(string)),
+		Comment:   gofastly.ToPointer(comment),
 	}
 
 	subnet := convertSubnetToInt(v["subnet"].(string))
@@ -329,6 +465,487 @@ func buildBatchACLEntry(v map[string]any, op gofastly.BatchOperation) *gofastly.
 	return entry
 }
 
+// retagACLEntries moves the entries owned with the previous `comment_tag` to
+// the new one.
+func retagACLEntries(conn *gofastly.Client, d *schema.ResourceData) error {
+	serviceID := d.Get("service_id").(string)
+	aclID := d.Get("acl_id").(string)
+	o, n := d.GetChange("comment_tag")
+
+	// Without a previous tag, the entries Terraform owned are the ones in
+	// the previous `entry` blocks or written from the previous `cidrs`.
+	ids := make(map[string]bool)
+	oe, _ := d.GetChange("entry")
+	for _, e := range oe.(*schema.Set).List() {
+		ids[e.(map[string]any)["id"].(string)] = true
+	}
+	oc, _ := d.GetChange("cidrs")
+	previous, err := aggregateACLCIDRs(buildStringSlice(oc.(*schema.Set)))
+	if err != nil {
+		return err
+	}
+	cidrs := make(map[aclCIDR]bool, len(previous))
+	for _, c := range previous {
+		cidrs[c] = true
+	}
+
+	remoteState, err := getAllAclEntriesViaPaginator(conn, &gofastly.GetACLEntriesInput{
+		ServiceID: serviceID,
+		ACLID:     aclID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return executeBatchACLOperations(conn, serviceID, aclID, buildACLEntryRetags(remoteState, ids, cidrs, o.(string), n.(string)))
+}
+
+// buildACLEntryRetags builds the batch operations moving the owned entries
+// from the old tag to the new one. With an old tag the owned entries are the
+// tagged ones, otherwise the ones with the given IDs or CIDRs.
+func buildACLEntryRetags(remoteState []*gofastly.ACLEntry, ids map[string]bool, cidrs map[aclCIDR]bool, oldTag, newTag string) []*gofastly.BatchACLEntry {
+	var owned []*gofastly.ACLEntry
+	if oldTag != "" {
+		owned = filterTaggedACLEntries(remoteState, oldTag)
+	} else {
+		for _, e := range remoteState {
+			c, ok := flattenACLCIDR(e)
+			if ids[gofastly.ToValue(e.EntryID)] || (ok && cidrs[c]) {
+				owned = append(owned, e)
+			}
+		}
+	}
+
+	batchACLEntries := []*gofastly.BatchACLEntry{}
+	for _, e := range owned {
+		comment := gofastly.ToValue(e.Comment)
+		if newTag != "" {
+			comment = strings.TrimSpace(newTag + " " + comment)
+		}
+		batchACLEntries = append(batchACLEntries, &gofastly.BatchACLEntry{
+			Operation: gofastly.ToPointer(gofastly.UpdateBatchOperation),
+			EntryID:   e.EntryID,
+			IP:        e.IP,
+			Subnet:    e.Subnet,
+			Negated:   gofastly.ToPointer(gofastly.Compatibool(gofastly.ToValue(e.Negated))),
+			Comment:   gofastly.ToPointer(comment),
+		})
+	}
+	return batchACLEntries
+}
+
+// filterTaggedACLEntries returns the entries whose comment is the tag or
+// starts with the tag followed by a space, with the tag stripped from the
+// comment.
+func filterTaggedACLEntries(entries []*gofastly.ACLEntry, tag string) []*gofastly.ACLEntry {
+	var result []*gofastly.ACLEntry
+	for _, e := range entries {
+		comment := gofastly.ToValue(e.Comment)
+		if comment != tag && !strings.HasPrefix(comment, tag+" ") {
+			continue
+		}
+		tagged := *e
+		tagged.Comment = gofastly.ToPointer(strings.TrimPrefix(strings.TrimPrefix(comment, tag), " "))
+		result = append(result, &tagged)
+	}
+	return result
+}
+
+// resourceServiceACLEntriesCustomizeDiff plans the aggregated entries when
+// `cidrs` is used, so that the plan shows the change to `aggregated_cidrs`.
+func resourceServiceACLEntriesCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.NewValueKnown("cidrs") {
+		return d.SetNewComputed("aggregated_cidrs")
+	}
+	cidrs := d.Get("cidrs").(*schema.Set)
+	if cidrs.Len() == 0 {
+		if d.HasChange("cidrs") {
+			return d.SetNewComputed("aggregated_cidrs")
+		}
+		return nil
+	}
+
+	desired, err := aggregateACLCIDRs(buildStringSlice(cidrs))
+	if err != nil {
+		return err
+	}
+	if len(desired) > gofastly.MaximumACLSize {
+		return fmt.Errorf("cidrs aggregate into %d entries, more than the maximum of %d entries in an ACL", len(desired), gofastly.MaximumACLSize)
+	}
+
+	planned := make([]string, 0, len(desired))
+	for _, c := range desired {
+		planned = append(planned, c.String())
+	}
+
+	if aclEntriesManaged(d) {
+		// Drift is corrected, so the ACL ends up with exactly the planned
+		// entries.
+		if d.Id() == "" || !slices.Equal(planned, buildStringList(d.Get("aggregated_cidrs").([]any))) {
+			return d.SetNew("aggregated_cidrs", planned)
+		}
+		return nil
+	}
+
+	// Entries added outside of Terraform are kept, so the resulting entries
+	// are only known after apply.
+	if d.Id() == "" || d.HasChange("cidrs") {
+		return d.SetNewComputed("aggregated_cidrs")
+	}
+	return nil
+}
+
+// aclEntriesManaged reports whether Terraform corrects drift of the entries,
+// i.e. removes entries it did not write.
+func aclEntriesManaged(d interface{ Get(string) any }) bool {
+	return d.Get("manage_entries").(bool) || d.Get("comment_tag").(string) != ""
+}
+
+// aclCIDR is a normalised ACL entry: a masked prefix, optionally negated.
+type aclCIDR struct {
+	Prefix  netip.Prefix
+	Negated bool
+}
+
+// String returns the CIDR, prefixed with `!` if negated.
+func (c aclCIDR) String() string {
+	if c.Negated {
+		return "!" + c.Prefix.String()
+	}
+	return c.Prefix.String()
+}
+
+// batchEntry builds a batch operation creating the entry for the CIDR.
+func (c aclCIDR) batchEntry(op gofastly.BatchOperation, tag string) *gofastly.BatchACLEntry {
+	return &gofastly.BatchACLEntry{
+		Operation: gofastly.ToPointer(op),
+		IP:        gofastly.ToPointer(c.Prefix.Addr().String()),
+		Subnet:    gofastly.ToPointer(c.Prefix.Bits()),
+		Negated:   gofastly.ToPointer(gofastly.Compatibool(c.Negated)),
+		Comment:   gofastly.ToPointer(tag),
+	}
+}
+
+// normaliseACLPrefix masks the host bits of a prefix and converts IPv4-mapped
+// IPv6 prefixes to IPv4.
+func normaliseACLPrefix(p netip.Prefix) netip.Prefix {
+	if p.Addr().Is4In6() && p.Bits() >= 96 {
+		p = netip.PrefixFrom(p.Addr().Unmap(), p.Bits()-96)
+	}
+	return p.Masked()
+}
+
+// parseACLCIDR parses an IP address or CIDR, optionally negated with a leading
+// `!`. A single address is treated as a /32 (IPv4) or /128 (IPv6) CIDR.
+func parseACLCIDR(s string) (netip.Prefix, bool, error) {
+	negated := strings.HasPrefix(s, "!")
+	s = strings.TrimSpace(strings.TrimPrefix(s, "!"))
+
+	if strings.Contains(s, "/") {
+		p, err := netip.ParsePrefix(s)
+		if err != nil {
+			return netip.Prefix{}, false, err
+		}
+		return normaliseACLPrefix(p), negated, nil
+	}
+
+	a, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, false, err
+	}
+	if a.Zone() != "" {
+		return netip.Prefix{}, false, fmt.Errorf("IPv6 zones are not supported: %s", s)
+	}
+	return normaliseACLPrefix(netip.PrefixFrom(a, a.BitLen())), negated, nil
+}
+
+// aggregateACLCIDRs parses, deduplicates and aggregates a list of CIDRs into
+// the smallest equivalent list of ACL entries, sorted by address. Prefixes
+// contained in another prefix are dropped and adjacent prefixes are merged.
+// Negated and non-negated CIDRs are aggregated separately, around the CIDRs of
+// the other polarity so that the longest matching prefix is unchanged.
+func aggregateACLCIDRs(cidrs []string) ([]aclCIDR, error) {
+	var allowed, negated []netip.Prefix
+	for _, s := range cidrs {
+		p, neg, err := parseACLCIDR(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR %q: %w", s, err)
+		}
+		if neg {
+			negated = append(negated, p)
+		} else {
+			allowed = append(allowed, p)
+		}
+	}
+
+	var result []aclCIDR
+	for _, p := range aggregatePrefixesAround(allowed, negated) {
+		result = append(result, aclCIDR{Prefix: p})
+	}
+	for _, p := range aggregatePrefixesAround(negated, allowed) {
+		for _, c := range result {
+			if !c.Negated && c.Prefix == p {
+				return nil, fmt.Errorf("CIDR %s is both negated and not negated", p)
+			}
+		}
+		result = append(result, aclCIDR{Prefix: p, Negated: true})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if c := comparePrefixes(result[i].Prefix, result[j].Prefix); c != 0 {
+			return c < 0
+		}
+		return !result[i].Negated && result[j].Negated
+	})
+	return result, nil
+}
+
+// comparePrefixes orders prefixes by address (IPv4 first), then by prefix
+// length.
+func comparePrefixes(a, b netip.Prefix) int {
+	if c := a.Addr().Compare(b.Addr()); c != 0 {
+		return c
+	}
+	return a.Bits() - b.Bits()
+}
+
+// aggregatePrefixes returns the smallest list of prefixes covering exactly
+// the same addresses as the given (normalised) prefixes.
+func aggregatePrefixes(prefixes []netip.Prefix) []netip.Prefix {
+	return aggregatePrefixesAround(prefixes, nil)
+}
+
+// aggregatePrefixesAround aggregates the prefixes like aggregatePrefixes, but
+// never drops or merges a prefix into a prefix that strictly contains one of
+// the barriers. ACL entries match on the longest prefix, so this keeps the
+// entries of one polarity nested inside the entries of the other.
+func aggregatePrefixesAround(prefixes, barriers []netip.Prefix) []netip.Prefix {
+	sorted := slices.Clone(prefixes)
+	slices.SortFunc(sorted, comparePrefixes)
+
+	// surrounds reports whether p strictly contains one of the barriers.
+	surrounds := func(p netip.Prefix) bool {
+		for _, b := range barriers {
+			if b.Bits() > p.Bits() && p.Contains(b.Addr()) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var result []netip.Prefix
+	for _, p := range sorted {
+		// A shorter prefix sorts before the prefixes it contains.
+		if n := len(result); n > 0 && result[n-1].Bits() <= p.Bits() && result[n-1].Contains(p.Addr()) && (result[n-1] == p || !surrounds(result[n-1])) {
+			continue
+		}
+		result = append(result, p)
+
+		// Merge the last two prefixes for as long as they are the two halves
+		// of their parent prefix.
+		for n := len(result); n >= 2; n = len(result) {
+			a, b := result[n-2], result[n-1]
+			if a.Bits() != b.Bits() || a.Bits() == 0 || a.Addr().Is4() != b.Addr().Is4() {
+				break
+			}
+			parent := netip.PrefixFrom(a.Addr(), a.Bits()-1).Masked()
+			if parent != netip.PrefixFrom(b.Addr(), b.Bits()-1).Masked() || surrounds(parent) {
+				break
+			}
+			result = append(result[:n-2], parent)
+		}
+	}
+	return result
+}
+
+// flattenACLCIDR returns the normalised CIDR of a remote ACL entry.
+func flattenACLCIDR(e *gofastly.ACLEntry) (aclCIDR, bool) {
+	a, err := netip.ParseAddr(gofastly.ToValue(e.IP))
+	if err != nil {
+		return aclCIDR{}, false
+	}
+	bits := a.BitLen()
+	if e.Subnet != nil {
+		bits = *e.Subnet
+	}
+	p := netip.PrefixFrom(a, bits)
+	if !p.IsValid() {
+		return aclCIDR{}, false
+	}
+	return aclCIDR{Prefix: normaliseACLPrefix(p), Negated: gofastly.ToValue(e.Negated)}, true
+}
+
+// flattenACLCIDRs models the remote entries as a sorted list of CIDRs suitable
+// for saving to Terraform state.
+func flattenACLCIDRs(remoteState []*gofastly.ACLEntry) []string {
+	var cidrs []aclCIDR
+	for _, e := range remoteState {
+		c, ok := flattenACLCIDR(e)
+		if !ok {
+			log.Printf("[WARN] Ignoring ACL entry (%s) with invalid IP (%s)", gofastly.ToValue(e.EntryID), gofastly.ToValue(e.IP))
+			continue
+		}
+		cidrs = append(cidrs, c)
+	}
+	sort.Slice(cidrs, func(i, j int) bool {
+		if c := comparePrefixes(cidrs[i].Prefix, cidrs[j].Prefix); c != 0 {
+			return c < 0
+		}
+		return !cidrs[i].Negated && cidrs[j].Negated
+	})
+
+	result := make([]string, 0, len(cidrs))
+	for _, c := range cidrs {
+		result = append(result, c.String())
+	}
+	return result
+}
+
+// syncACLCIDRs brings the entries in the ACL in line with `cidrs`. Remote
+// entries that are no longer wanted are deleted if Terraform manages the
+// entries, or if Terraform wrote them (they were in the previous `cidrs` or
+// `entry` blocks). Missing entries are created.
+func syncACLCIDRs(conn *gofastly.Client, d *schema.ResourceData) error {
+	serviceID := d.Get("service_id").(string)
+	aclID := d.Get("acl_id").(string)
+	tag := d.Get("comment_tag").(string)
+
+	o, n := d.GetChange("cidrs")
+	desired, err := aggregateACLCIDRs(buildStringSlice(n.(*schema.Set)))
+	if err != nil {
+		return err
+	}
+	previous, err := aggregateACLCIDRs(buildStringSlice(o.(*schema.Set)))
+	if err != nil {
+		return err
+	}
+	owned := make(map[aclCIDR]bool)
+	for _, c := range previous {
+		owned[c] = true
+	}
+	oe, _ := d.GetChange("entry")
+	for _, e := range oe.(*schema.Set).List() {
+		e := e.(map[string]any)
+		c, ok := flattenACLCIDR(&gofastly.ACLEntry{
+			IP:      gofastly.ToPointer(e["ip"].(string)),
+			Negated: gofastly.ToPointer(e["negated"].(bool)),
+			Subnet:  subnetPointer(e["subnet"].(string)),
+		})
+		if ok {
+			owned[c] = true
+		}
+	}
+
+	remoteState, err := getAllAclEntriesViaPaginator(conn, &gofastly.GetACLEntriesInput{
+		ServiceID: serviceID,
+		ACLID:     aclID,
+	})
+	if err != nil {
+		return err
+	}
+	if tag != "" {
+		remoteState = filterTaggedACLEntries(remoteState, tag)
+	}
+
+	batchACLEntries := buildACLCIDRSync(remoteState, desired, owned, aclEntriesManaged(d), tag)
+
+	log.Printf("[DEBUG] Synchronising ACL (%s) CIDRs: %d operations", aclID, len(batchACLEntries))
+
+	return executeBatchACLOperations(conn, serviceID, aclID, batchACLEntries)
+}
+
+// buildACLCIDRSync builds the batch operations turning the remote entries into
+// the desired entries. Unless Terraform manages the entries, only the entries
+// it wrote (owned) are deleted: entries added outside of Terraform, including
+// entries whose IP cannot be parsed, are left alone.
+func buildACLCIDRSync(remoteState []*gofastly.ACLEntry, desired []aclCIDR, owned map[aclCIDR]bool, managed bool, tag string) []*gofastly.BatchACLEntry {
+	wanted := make(map[aclCIDR]bool, len(desired))
+	for _, c := range desired {
+		wanted[c] = true
+	}
+
+	var batchACLEntries []*gofastly.BatchACLEntry
+	existing := make(map[aclCIDR]bool)
+	for _, e := range remoteState {
+		c, ok := flattenACLCIDR(e)
+		if ok && wanted[c] && !existing[c] {
+			existing[c] = true
+			continue
+		}
+		// Duplicates of a wanted entry are always removed.
+		if !managed && (!ok || (!existing[c] && !owned[c])) {
+			continue
+		}
+		batchACLEntries = append(batchACLEntries, &gofastly.BatchACLEntry{
+			Operation: gofastly.ToPointer(gofastly.DeleteBatchOperation),
+			EntryID:   e.EntryID,
+		})
+	}
+	for _, c := range desired {
+		if !existing[c] {
+			batchACLEntries = append(batchACLEntries, c.batchEntry(gofastly.CreateBatchOperation, tag))
+		}
+	}
+	return batchACLEntries
+}
+
+// buildACLCIDRDeletes builds the batch operations deleting the entries written
+// from `cidrs`, or every entry read into `aggregated_cidrs` if Terraform
+// manages the entries.
+func buildACLCIDRDeletes(conn *gofastly.Client, d *schema.ResourceData, cidrs []string) ([]*gofastly.BatchACLEntry, error) {
+	// The entries were written aggregated, so the aggregated CIDRs are
+	// matched as well as the configured ones.
+	aggregated, err := aggregateACLCIDRs(cidrs)
+	if err != nil {
+		return nil, err
+	}
+	owned := slices.Clone(cidrs)
+	for _, c := range aggregated {
+		owned = append(owned, c.String())
+	}
+	if aclEntriesManaged(d) {
+		owned = append(owned, buildStringList(d.Get("aggregated_cidrs").([]any))...)
+	}
+	remove := make(map[aclCIDR]bool, len(owned))
+	for _, s := range owned {
+		p, negated, err := parseACLCIDR(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR %q: %w", s, err)
+		}
+		remove[aclCIDR{Prefix: p, Negated: negated}] = true
+	}
+
+	remoteState, err := getAllAclEntriesViaPaginator(conn, &gofastly.GetACLEntriesInput{
+		ServiceID: d.Get("service_id").(string),
+		ACLID:     d.Get("acl_id").(string),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if tag := d.Get("comment_tag").(string); tag != "" {
+		remoteState = filterTaggedACLEntries(remoteState, tag)
+	}
+
+	var batchACLEntries []*gofastly.BatchACLEntry
+	for _, e := range remoteState {
+		if c, ok := flattenACLCIDR(e); ok && remove[c] {
+			batchACLEntries = append(batchACLEntries, &gofastly.BatchACLEntry{
+				Operation: gofastly.ToPointer(gofastly.DeleteBatchOperation),
+				EntryID:   e.EntryID,
+			})
+		}
+	}
+	return batchACLEntries, nil
+}
+
+// subnetPointer returns the subnet as an int pointer, or nil if it is not set.
+func subnetPointer(s string) *int {
+	if s == "" {
+		return nil
+	}
+	return gofastly.ToPointer(convertSubnetToInt(s))
+}
+
 func convertSubnetToInt(s string) int {
 	subnet, _ := strconv.Atoi(s)
 	return subnet
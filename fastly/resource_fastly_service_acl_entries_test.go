@@ -2,9 +2,11 @@ package fastly
 
 import (
 	"fmt"
+	"net/netip"
 	"reflect"
 	"sort"
 	"strconv"
+	"strings"
 	"testing"
 
 	gofastly "github.com/fastly/go-fastly/v9/fastly"
@@ -62,6 +64,262 @@ func TestResourceFastlyFlattenAclEntries(t *testing.T) {
 	}
 }
 
+func TestFilterTaggedACLEntries(t *testing.T) {
+	entries := []*gofastly.ACLEntry{
+		{EntryID: gofastly.ToPointer("1"), Comment: gofastly.ToPointer("[team-a] office")},
+		{EntryID: gofastly.ToPointer("2"), Comment: gofastly.ToPointer("[team-a]")},
+		{EntryID: gofastly.ToPointer("3"), Comment: gofastly.ToPointer("[team-b] office")},
+		{EntryID: gofastly.ToPointer("4"), Comment: gofastly.ToPointer("[team-a]x")},
+		{EntryID: gofastly.ToPointer("5")},
+	}
+
+	got := filterTaggedACLEntries(entries, "[team-a]")
+	want := []*gofastly.ACLEntry{
+		{EntryID: gofastly.ToPointer("1"), Comment: gofastly.ToPointer("office")},
+		{EntryID: gofastly.ToPointer("2"), Comment: gofastly.ToPointer("")},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Error matching:\nexpected: %#v\ngot: %#v", want, got)
+	}
+
+	// The remote entries must not be modified.
+	if *entries[0].Comment != "[team-a] office" {
+		t.Errorf("unexpected modification of the remote entry: %s", *entries[0].Comment)
+	}
+}
+
+func TestBuildACLEntryDeletes(t *testing.T) {
+	// The state may still hold entries of other teams, read before the tag
+	// was set.
+	entries := []any{
+		map[string]any{"id": "1", "ip": "192.0.2.1"},
+		map[string]any{"id": "2", "ip": "192.0.2.2"},
+		map[string]any{"id": "3", "ip": "192.0.2.3"},
+	}
+	tagged := filterTaggedACLEntries([]*gofastly.ACLEntry{
+		{EntryID: gofastly.ToPointer("1"), Comment: gofastly.ToPointer("[team-a]")},
+		{EntryID: gofastly.ToPointer("2"), Comment: gofastly.ToPointer("[team-b]")},
+		{EntryID: gofastly.ToPointer("3"), Comment: gofastly.ToPointer("[team-a] office")},
+	}, "[team-a]")
+
+	ids := func(batch []*gofastly.BatchACLEntry) []string {
+		var result []string
+		for _, e := range batch {
+			result = append(result, gofastly.ToValue(e.EntryID))
+		}
+		sort.Strings(result)
+		return result
+	}
+
+	if got, want := ids(buildACLEntryDeletes(entries, true, tagged)), []string{"1", "3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("want %#v, got %#v", want, got)
+	}
+	if got, want := ids(buildACLEntryDeletes(entries, false, nil)), []string{"1", "2", "3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("want %#v, got %#v", want, got)
+	}
+}
+
+func TestBuildACLEntryRetags(t *testing.T) {
+	remoteState := []*gofastly.ACLEntry{
+		{EntryID: gofastly.ToPointer("1"), IP: gofastly.ToPointer("192.0.2.1"), Comment: gofastly.ToPointer("[team-a]")},
+		{EntryID: gofastly.ToPointer("2"), IP: gofastly.ToPointer("192.0.2.2"), Comment: gofastly.ToPointer("[team-b] office")},
+		{EntryID: gofastly.ToPointer("3"), IP: gofastly.ToPointer("192.0.2.3"), Comment: gofastly.ToPointer("[team-a] office")},
+		{EntryID: gofastly.ToPointer("4"), IP: gofastly.ToPointer("192.0.2.0"), Subnet: gofastly.ToPointer(24), Comment: gofastly.ToPointer("")},
+	}
+	cidr, _, _ := parseACLCIDR("192.0.2.0/24")
+
+	for _, tc := range []struct {
+		name   string
+		ids    map[string]bool
+		cidrs  map[aclCIDR]bool
+		oldTag string
+		newTag string
+		want   map[string]string
+	}{
+		{
+			name:   "tag changed",
+			oldTag: "[team-a]",
+			newTag: "[team-c]",
+			want:   map[string]string{"1": "[team-c]", "3": "[team-c] office"},
+		},
+		{
+			name:   "tag removed",
+			oldTag: "[team-a]",
+			want:   map[string]string{"1": "", "3": "office"},
+		},
+		{
+			name:   "tag added",
+			ids:    map[string]bool{"2": true},
+			cidrs:  map[aclCIDR]bool{{Prefix: cidr}: true},
+			newTag: "[team-c]",
+			want:   map[string]string{"2": "[team-c] [team-b] office", "4": "[team-c]"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := make(map[string]string)
+			for _, e := range buildACLEntryRetags(remoteState, tc.ids, tc.cidrs, tc.oldTag, tc.newTag) {
+				if op := gofastly.ToValue(e.Operation); op != gofastly.UpdateBatchOperation {
+					t.Errorf("unexpected operation %s", op)
+				}
+				got[gofastly.ToValue(e.EntryID)] = gofastly.ToValue(e.Comment)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("want %#v, got %#v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestBuildBatchACLEntryTag(t *testing.T) {
+	for comment, want := range map[string]string{
+		"office": "[team-a] office",
+		"":       "[team-a]",
+	} {
+		entry := buildBatchACLEntry(map[string]any{
+			"comment": comment,
+			"id":      "",
+			"ip":      "127.0.0.1",
+			"negated": false,
+			"subnet":  "",
+		}, gofastly.CreateBatchOperation, "[team-a]")
+		if got := gofastly.ToValue(entry.Comment); got != want {
+			t.Errorf("want %q, got %q", want, got)
+		}
+	}
+}
+
+func TestAggregateACLCIDRs(t *testing.T) {
+	cases := []struct {
+		name  string
+		cidrs []string
+		want  []string
+	}{
+		{
+			name:  "single addresses",
+			cidrs: []string{"192.0.2.1", "2001:db8::1"},
+			want:  []string{"192.0.2.1/32", "2001:db8::1/128"},
+		},
+		{
+			name:  "host bits are masked and duplicates removed",
+			cidrs: []string{"192.0.2.1/24", "192.0.2.0/24", "2001:db8::1/32"},
+			want:  []string{"192.0.2.0/24", "2001:db8::/32"},
+		},
+		{
+			name:  "contained prefixes are dropped",
+			cidrs: []string{"192.0.2.0/24", "192.0.2.128/25", "192.0.2.7", "198.51.100.0/24"},
+			want:  []string{"192.0.2.0/24", "198.51.100.0/24"},
+		},
+		{
+			name:  "adjacent prefixes are merged",
+			cidrs: []string{"192.0.2.0/26", "192.0.2.64/26", "192.0.2.128/25", "192.0.3.0/24"},
+			want:  []string{"192.0.2.0/23"},
+		},
+		{
+			name:  "adjacent prefixes with different parents are kept",
+			cidrs: []string{"192.0.2.128/25", "192.0.3.0/25"},
+			want:  []string{"192.0.2.128/25", "192.0.3.0/25"},
+		},
+		{
+			name:  "negated prefixes are aggregated separately",
+			cidrs: []string{"192.0.2.0/24", "!192.0.2.0/26", "!192.0.2.64/26"},
+			want:  []string{"192.0.2.0/24", "!192.0.2.0/25"},
+		},
+		{
+			name:  "prefixes around a nested negation are kept",
+			cidrs: []string{"10.0.0.0/8", "!10.1.0.0/16", "10.1.2.0/24"},
+			want:  []string{"10.0.0.0/8", "!10.1.0.0/16", "10.1.2.0/24"},
+		},
+		{
+			name:  "halves around a nested negation are not merged",
+			cidrs: []string{"192.0.2.0/25", "192.0.2.128/25", "!192.0.2.64/26"},
+			want:  []string{"192.0.2.0/25", "!192.0.2.64/26", "192.0.2.128/25"},
+		},
+		{
+			name:  "IPv4-mapped IPv6 addresses are converted",
+			cidrs: []string{"::ffff:192.0.2.1", "::ffff:192.0.2.0/120"},
+			want:  []string{"192.0.2.0/24"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			result, err := aggregateACLCIDRs(c.cidrs)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			got := make([]string, 0, len(result))
+			for _, r := range result {
+				got = append(got, r.String())
+			}
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("want %v, got %v", c.want, got)
+			}
+		})
+	}
+
+	for _, cidrs := range [][]string{
+		{"192.0.2.0/33"},
+		{"192.0.2.0/24", "!192.0.2.0/24"},
+	} {
+		if _, err := aggregateACLCIDRs(cidrs); err == nil {
+			t.Errorf("expected error for %v", cidrs)
+		}
+	}
+}
+
+func TestFlattenACLCIDRs(t *testing.T) {
+	entries := []*gofastly.ACLEntry{
+		{EntryID: gofastly.ToPointer("1"), IP: gofastly.ToPointer("2001:db8::"), Subnet: gofastly.ToPointer(32)},
+		{EntryID: gofastly.ToPointer("2"), IP: gofastly.ToPointer("192.0.2.5"), Subnet: gofastly.ToPointer(24), Negated: gofastly.ToPointer(true)},
+		{EntryID: gofastly.ToPointer("3"), IP: gofastly.ToPointer("192.0.2.1")},
+		{EntryID: gofastly.ToPointer("4"), IP: gofastly.ToPointer("invalid")},
+	}
+
+	want := []string{"!192.0.2.0/24", "192.0.2.1/32", "2001:db8::/32"}
+	if got := flattenACLCIDRs(entries); !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+}
+
+func TestBuildACLCIDRSync(t *testing.T) {
+	remoteState := []*gofastly.ACLEntry{
+		{EntryID: gofastly.ToPointer("kept"), IP: gofastly.ToPointer("192.0.2.0"), Subnet: gofastly.ToPointer(24)},
+		{EntryID: gofastly.ToPointer("duplicate"), IP: gofastly.ToPointer("192.0.2.0"), Subnet: gofastly.ToPointer(24)},
+		{EntryID: gofastly.ToPointer("owned"), IP: gofastly.ToPointer("198.51.100.1")},
+		{EntryID: gofastly.ToPointer("external"), IP: gofastly.ToPointer("203.0.113.1")},
+		{EntryID: gofastly.ToPointer("invalid"), IP: gofastly.ToPointer("not an IP")},
+	}
+	desired, err := aggregateACLCIDRs([]string{"192.0.2.0/24", "2001:db8::/32"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	owned := map[aclCIDR]bool{
+		{Prefix: netip.MustParsePrefix("198.51.100.1/32")}: true,
+	}
+
+	ops := func(batch []*gofastly.BatchACLEntry) []string {
+		var result []string
+		for _, e := range batch {
+			result = append(result, fmt.Sprintf("%s %s%s", gofastly.ToValue(e.Operation), gofastly.ToValue(e.EntryID), gofastly.ToValue(e.IP)))
+		}
+		return result
+	}
+
+	// Entries added outside of Terraform, including unparseable ones, are
+	// left alone unless Terraform manages the entries.
+	got := ops(buildACLCIDRSync(remoteState, desired, owned, false, ""))
+	want := []string{"delete duplicate", "delete owned", "create 2001:db8::"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unmanaged: want %v, got %v", want, got)
+	}
+
+	got = ops(buildACLCIDRSync(remoteState, desired, owned, true, ""))
+	want = []string{"delete duplicate", "delete owned", "delete external", "delete invalid", "create 2001:db8::"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("managed: want %v, got %v", want, got)
+	}
+}
+
 func TestAccFastlyServiceAclEntries_create(t *testing.T) {
 	var service gofastly.ServiceDetail
 	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
@@ -526,3 +784,77 @@ resource "fastly_service_vcl" "foo" {
 	%s
 }`, aclName, serviceName, domainName, backendName, manageEntries, aclEntries)
 }
+
+func TestAccFastlyServiceAclEntries_cidrs(t *testing.T) {
+	var service gofastly.ServiceDetail
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	aclName := fmt.Sprintf("ACL %s", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckServiceVCLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceACLEntriesConfigOneACLWithCIDRs(serviceName, aclName, []string{"192.0.2.0/25", "192.0.2.128/25", "192.0.2.7", "!2001:db8::1"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists("fastly_service_vcl.foo", &service),
+					testAccCheckFastlyServiceACLEntriesRemoteState(&service, serviceName, aclName, []map[string]any{
+						{"id": "", "ip": "192.0.2.0", "subnet": "24", "negated": false, "comment": ""},
+						{"id": "", "ip": "2001:db8::1", "subnet": "128", "negated": true, "comment": ""},
+					}),
+					resource.TestCheckResourceAttr("fastly_service_acl_entries.entries", "aggregated_cidrs.#", "2"),
+					resource.TestCheckResourceAttr("fastly_service_acl_entries.entries", "aggregated_cidrs.0", "192.0.2.0/24"),
+					resource.TestCheckResourceAttr("fastly_service_acl_entries.entries", "aggregated_cidrs.1", "!2001:db8::1/128"),
+					resource.TestCheckResourceAttr("fastly_service_acl_entries.entries", "entry.#", "0"),
+				),
+			},
+			{
+				Config: testAccServiceACLEntriesConfigOneACLWithCIDRs(serviceName, aclName, []string{"192.0.2.0/24", "198.51.100.0/24"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists("fastly_service_vcl.foo", &service),
+					testAccCheckFastlyServiceACLEntriesRemoteState(&service, serviceName, aclName, []map[string]any{
+						{"id": "", "ip": "192.0.2.0", "subnet": "24", "negated": false, "comment": ""},
+						{"id": "", "ip": "198.51.100.0", "subnet": "24", "negated": false, "comment": ""},
+					}),
+					resource.TestCheckResourceAttr("fastly_service_acl_entries.entries", "aggregated_cidrs.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServiceACLEntriesConfigOneACLWithCIDRs(serviceName, aclName string, cidrs []string) string {
+	backendName := fmt.Sprintf("%s.aws.amazon.com", acctest.RandString(3))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	return fmt.Sprintf(`
+variable "myacl_name" {
+	type = string
+	default = "%s"
+}
+
+resource "fastly_service_vcl" "foo" {
+	name = "%s"
+	domain {
+		name    = "%s"
+		comment = "tf-testing-domain"
+	}
+	backend {
+		address = "%s"
+		name    = "tf-testing-backend"
+	}
+	acl {
+		name       = var.myacl_name
+	}
+	force_destroy = true
+}
+ resource "fastly_service_acl_entries" "entries" {
+	service_id = fastly_service_vcl.foo.id
+	acl_id = {for s in fastly_service_vcl.foo.acl : s.name => s.acl_id}[var.myacl_name]
+	manage_entries = true
+	cidrs = ["%s"]
+}`, aclName, serviceName, domainName, backendName, strings.Join(cidrs, `", "`))
+}
@@ -0,0 +1,267 @@
+package fastly
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceServiceAuthorizations() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceServiceAuthorizationsCreate,
+		ReadContext:   resourceServiceAuthorizationsRead,
+		UpdateContext: resourceServiceAuthorizationsUpdate,
+		DeleteContext: resourceServiceAuthorizationsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceServiceAuthorizationsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"authoritative": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to remove the authorizations for the service of users not in `permissions`. If `false`, such authorizations are left alone. Default `false`",
+			},
+			"authorization_ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "A map of user ID to the ID of the service authorization granting the user's permission.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"permissions": {
+				Type:             schema.TypeMap,
+				Required:         true,
+				Description:      "A map of user ID to the permission to grant the user on the service. Permissions can be `full`, `read_only`, `purge_select` or `purge_all`.",
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validateServiceAuthorizationPermissions(),
+			},
+			"service_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the service to grant permissions for.",
+			},
+		},
+	}
+}
+
+func resourceServiceAuthorizationsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	d.SetId(d.Get("service_id").(string))
+
+	if err := syncServiceAuthorizations(d, meta.(*APIClient).conn, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceServiceAuthorizationsRead(ctx, d, meta)
+}
+
+func resourceServiceAuthorizationsRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	log.Printf("[DEBUG] Refreshing Service Authorizations for (%s)", d.Id())
+	conn := meta.(*APIClient).conn
+
+	remote, err := listServiceAuthorizations(conn, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Users missing remotely are dropped, so that the next plan grants their
+	// permission again. In authoritative mode, users that should not have a
+	// permission are added, so that the next plan removes it.
+	declared := d.Get("permissions").(map[string]any)
+	authoritative := d.Get("authoritative").(bool)
+
+	permissions := map[string]string{}
+	ids := map[string]string{}
+	for userID, sa := range remote {
+		if _, ok := declared[userID]; ok || authoritative {
+			permissions[userID] = sa.Permission
+			ids[userID] = sa.ID
+		}
+	}
+
+	if err := d.Set("permissions", permissions); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("authorization_ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceServiceAuthorizationsUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	o, _ := d.GetChange("permissions")
+
+	if err := syncServiceAuthorizations(d, meta.(*APIClient).conn, buildStringMap(o.(map[string]any))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceServiceAuthorizationsRead(ctx, d, meta)
+}
+
+func resourceServiceAuthorizationsDelete(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	remote, err := listServiceAuthorizations(conn, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Only the declared permissions are removed, even in authoritative mode.
+	for _, userID := range sortedKeys(d.Get("permissions").(map[string]any)) {
+		sa, ok := remote[userID]
+		if !ok {
+			continue
+		}
+		log.Printf("[DEBUG] Removing permission of user (%s) on service (%s)", userID, d.Id())
+		if err := conn.DeleteServiceAuthorization(&gofastly.DeleteServiceAuthorizationInput{
+			ID: sa.ID,
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// resourceServiceAuthorizationsImport imports all the authorizations of a
+// service, as the permissions to manage are not known yet.
+func resourceServiceAuthorizationsImport(_ context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	remote, err := listServiceAuthorizations(meta.(*APIClient).conn, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	permissions := make(map[string]string, len(remote))
+	for userID, sa := range remote {
+		permissions[userID] = sa.Permission
+	}
+
+	if err := d.Set("service_id", d.Id()); err != nil {
+		return nil, err
+	}
+	if err := d.Set("authoritative", false); err != nil {
+		return nil, err
+	}
+	if err := d.Set("permissions", permissions); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// serviceAuthorizationChanges are the API calls reconciling the authorizations
+// of a service with the declared permissions.
+type serviceAuthorizationChanges struct {
+	// Create maps user IDs to the permission to grant.
+	Create map[string]string
+	// Update maps authorization IDs to their new permission.
+	Update map[string]string
+	// Delete lists the authorization IDs to remove.
+	Delete []string
+}
+
+// diffServiceAuthorizations plans the changes from the remote authorizations
+// (keyed by user ID) to the declared permissions. Users in previous, i.e.
+// declared before, lose their permission when they are no longer declared.
+// Other undeclared users only lose theirs in authoritative mode.
+func diffServiceAuthorizations(remote map[string]*gofastly.ServiceAuthorization, declared, previous map[string]string, authoritative bool) serviceAuthorizationChanges {
+	changes := serviceAuthorizationChanges{
+		Create: map[string]string{},
+		Update: map[string]string{},
+	}
+
+	for userID, permission := range declared {
+		sa, ok := remote[userID]
+		switch {
+		case !ok:
+			changes.Create[userID] = permission
+		case sa.Permission != permission:
+			changes.Update[sa.ID] = permission
+		}
+	}
+
+	for _, userID := range sortedKeys(remote) {
+		if _, ok := declared[userID]; ok {
+			continue
+		}
+		if _, ok := previous[userID]; ok || authoritative {
+			changes.Delete = append(changes.Delete, remote[userID].ID)
+		}
+	}
+
+	return changes
+}
+
+// syncServiceAuthorizations reconciles the authorizations of the service with
+// the declared permissions.
+func syncServiceAuthorizations(d *schema.ResourceData, conn *gofastly.Client, previous map[string]string) error {
+	serviceID := d.Get("service_id").(string)
+
+	remote, err := listServiceAuthorizations(conn, serviceID)
+	if err != nil {
+		return err
+	}
+
+	changes := diffServiceAuthorizations(remote, buildStringMap(d.Get("permissions").(map[string]any)), previous, d.Get("authoritative").(bool))
+
+	for _, id := range changes.Delete {
+		log.Printf("[DEBUG] Deleting service authorization (%s) on service (%s)", id, serviceID)
+		if err := conn.DeleteServiceAuthorization(&gofastly.DeleteServiceAuthorizationInput{
+			ID: id,
+		}); err != nil {
+			return fmt.Errorf("error deleting service authorization (%s): %w", id, err)
+		}
+	}
+	for _, id := range sortedKeys(changes.Update) {
+		log.Printf("[DEBUG] Updating service authorization (%s) on service (%s)", id, serviceID)
+		if _, err := conn.UpdateServiceAuthorization(&gofastly.UpdateServiceAuthorizationInput{
+			ID:         id,
+			Permission: changes.Update[id],
+		}); err != nil {
+			return fmt.Errorf("error updating service authorization (%s): %w", id, err)
+		}
+	}
+	for _, userID := range sortedKeys(changes.Create) {
+		log.Printf("[DEBUG] Granting user (%s) permission on service (%s)", userID, serviceID)
+		if _, err := conn.CreateServiceAuthorization(&gofastly.CreateServiceAuthorizationInput{
+			Service:    &gofastly.SAService{ID: serviceID},
+			User:       &gofastly.SAUser{ID: userID},
+			Permission: changes.Create[userID],
+		}); err != nil {
+			return fmt.Errorf("error granting user (%s) permission on service (%s): %w", userID, serviceID, err)
+		}
+	}
+
+	return nil
+}
+
+// listServiceAuthorizations returns the authorizations of a service, keyed by
+// user ID. The API only lists the authorizations of all the services, one page
+// at a time.
+func listServiceAuthorizations(conn *gofastly.Client, serviceID string) (map[string]*gofastly.ServiceAuthorization, error) {
+	result := map[string]*gofastly.ServiceAuthorization{}
+	for page := 1; ; page++ {
+		sas, err := conn.ListServiceAuthorizations(&gofastly.ListServiceAuthorizationsInput{
+			PageNumber: page,
+			PageSize:   100,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error listing service authorizations: %w", err)
+		}
+		for _, sa := range sas.Items {
+			if sa.DeletedAt != nil || sa.Service == nil || sa.User == nil || sa.Service.ID != serviceID {
+				continue
+			}
+			result[sa.User.ID] = sa
+		}
+		if sas.Info.Links.Next == "" || len(sas.Items) == 0 {
+			return result, nil
+		}
+	}
+}
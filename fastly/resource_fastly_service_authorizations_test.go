@@ -0,0 +1,132 @@
+package fastly
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	gofastly "github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDiffServiceAuthorizations(t *testing.T) {
+	remote := map[string]*gofastly.ServiceAuthorization{
+		"same":      {ID: "sa-same", Permission: "full"},
+		"changed":   {ID: "sa-changed", Permission: "read_only"},
+		"removed":   {ID: "sa-removed", Permission: "purge_all"},
+		"unmanaged": {ID: "sa-unmanaged", Permission: "full"},
+	}
+	declared := map[string]string{
+		"same":    "full",
+		"changed": "purge_select",
+		"new":     "read_only",
+	}
+	previous := map[string]string{
+		"same":    "full",
+		"changed": "read_only",
+		"removed": "purge_all",
+	}
+
+	for _, tc := range []struct {
+		name          string
+		authoritative bool
+		want          serviceAuthorizationChanges
+	}{
+		{
+			name: "non-authoritative",
+			want: serviceAuthorizationChanges{
+				Create: map[string]string{"new": "read_only"},
+				Update: map[string]string{"sa-changed": "purge_select"},
+				Delete: []string{"sa-removed"},
+			},
+		},
+		{
+			name:          "authoritative",
+			authoritative: true,
+			want: serviceAuthorizationChanges{
+				Create: map[string]string{"new": "read_only"},
+				Update: map[string]string{"sa-changed": "purge_select"},
+				Delete: []string{"sa-removed", "sa-unmanaged"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := diffServiceAuthorizations(remote, declared, previous, tc.authoritative)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAccFastlyServiceAuthorizations_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceAuthorizationsConfig(name, `
+    (fastly_user.one.id) = "purge_select"
+    (fastly_user.two.id) = "read_only"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_authorizations.auth", "permissions.%", "2"),
+					resource.TestCheckResourceAttr("fastly_service_authorizations.auth", "authorization_ids.%", "2"),
+				),
+			},
+			{
+				Config: testAccServiceAuthorizationsConfig(name, `
+    (fastly_user.one.id) = "purge_all"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_authorizations.auth", "permissions.%", "1"),
+					resource.TestCheckResourceAttr("fastly_service_authorizations.auth", "authorization_ids.%", "1"),
+				),
+			},
+			{
+				ResourceName:      "fastly_service_authorizations.auth",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Imports are never authoritative.
+				ImportStateVerifyIgnore: []string{"authoritative"},
+			},
+		},
+	})
+}
+
+func testAccServiceAuthorizationsConfig(name, permissions string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_vcl" "demo" {
+  name = "%[1]s"
+
+  domain {
+    name = "%[1]s.com"
+  }
+
+  force_destroy = true
+}
+
+resource "fastly_user" "one" {
+  login = "%[1]s-one@example.com"
+  name  = "tf-test"
+  role  = "engineer"
+}
+
+resource "fastly_user" "two" {
+  login = "%[1]s-two@example.com"
+  name  = "tf-test"
+  role  = "engineer"
+}
+
+resource "fastly_service_authorizations" "auth" {
+  service_id    = fastly_service_vcl.demo.id
+  authoritative = true
+  permissions = {%[2]s  }
+}
+`, name, permissions)
+}
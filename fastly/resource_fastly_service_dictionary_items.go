@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	gofastly "github.com/fastly/go-fastly/v9/fastly"
@@ -20,6 +21,7 @@ func resourceServiceDictionaryItems() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceServiceDictionaryItemsImport,
 		},
+		CustomizeDiff: validateKeyPrefix("items"),
 		Schema: map[string]*schema.Schema{
 			"dictionary_id": {
 				Type:        schema.TypeString,
@@ -34,9 +36,14 @@ func resourceServiceDictionaryItems() *schema.Resource {
 				ValidateDiagFunc: validateDictionaryItems(),
 				Elem:             schema.TypeString,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					return !d.HasChange("dictionary_id") && !d.Get("manage_items").(bool)
+					return !d.HasChange("dictionary_id") && !d.Get("manage_items").(bool) && d.Get("key_prefix").(string) == ""
 				},
 			},
+			"key_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only manage the items whose key starts with this prefix, so that several teams can share a dictionary. Items outside of the prefix are ignored (never read, diffed or deleted), items within it are always managed (as if `manage_items` was `true`). Every key in `items` must start with the prefix. The prefix can be changed (or added after an import) without replacing the resource",
+			},
 			"manage_items": {
 				Type:        schema.TypeBool,
 				Default:     false,
@@ -91,7 +98,9 @@ func resourceServiceDictionaryItemsUpdate(ctx context.Context, d *schema.Resourc
 
 		o, n := d.GetChange("items")
 
-		os := o.(map[string]any)
+		// The state may still hold items outside of a newly set prefix,
+		// which must be left alone.
+		os := filterKeyPrefix(o.(map[string]any), d.Get("key_prefix").(string))
 		ns := n.(map[string]any)
 
 		// Handle Removal
@@ -150,7 +159,9 @@ func resourceServiceDictionaryItemsRead(_ context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	err = d.Set("items", flattenDictionaryItems(remoteState))
+	items := filterKeyPrefix(flattenDictionaryItems(remoteState), d.Get("key_prefix").(string))
+
+	err = d.Set("items", items)
 	return diag.FromErr(err)
 }
 
@@ -161,14 +172,7 @@ func resourceServiceDictionaryItemsDelete(_ context.Context, d *schema.ResourceD
 	dictionaryID := d.Get("dictionary_id").(string)
 	items := d.Get("items").(map[string]any)
 
-	var batchDictionaryItems []*gofastly.BatchDictionaryItem
-
-	for key := range items {
-		batchDictionaryItems = append(batchDictionaryItems, &gofastly.BatchDictionaryItem{
-			Operation: gofastly.ToPointer(gofastly.DeleteBatchOperation),
-			ItemKey:   gofastly.ToPointer(key),
-		})
-	}
+	batchDictionaryItems := buildDictionaryItemsDeletes(items, d.Get("key_prefix").(string))
 
 	// Process the batch operations
 	err := executeBatchDictionaryOperations(conn, serviceID, dictionaryID, batchDictionaryItems)
@@ -203,6 +207,51 @@ func resourceServiceDictionaryItemsImport(_ context.Context, d *schema.ResourceD
 	return []*schema.ResourceData{d}, nil
 }
 
+// validateKeyPrefix returns a CustomizeDiffFunc checking that every key of the
+// map attribute starts with the configured `key_prefix`.
+func validateKeyPrefix(mapKey string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, _ any) error {
+		prefix := d.Get("key_prefix").(string)
+		if prefix == "" || !d.NewValueKnown(mapKey) {
+			return nil
+		}
+		var keys []string
+		for k := range d.Get(mapKey).(map[string]any) {
+			keys = append(keys, k)
+		}
+		return checkKeyPrefix(mapKey, keys, prefix)
+	}
+}
+
+// checkKeyPrefix returns an error naming the keys that do not start with
+// prefix.
+func checkKeyPrefix(attr string, keys []string, prefix string) error {
+	var invalid []string
+	for _, k := range keys {
+		if !strings.HasPrefix(k, prefix) {
+			invalid = append(invalid, k)
+		}
+	}
+	if len(invalid) > 0 {
+		sort.Strings(invalid)
+		return fmt.Errorf("every key in %s must start with key_prefix %q, got: %s", attr, prefix, strings.Join(invalid, ", "))
+	}
+	return nil
+}
+
+// buildDictionaryItemsDeletes builds the batch operations deleting the items,
+// skipping the items outside of the prefix.
+func buildDictionaryItemsDeletes(items map[string]any, prefix string) []*gofastly.BatchDictionaryItem {
+	var batchDictionaryItems []*gofastly.BatchDictionaryItem
+	for _, key := range sortedKeys(filterKeyPrefix(items, prefix)) {
+		batchDictionaryItems = append(batchDictionaryItems, &gofastly.BatchDictionaryItem{
+			Operation: gofastly.ToPointer(gofastly.DeleteBatchOperation),
+			ItemKey:   gofastly.ToPointer(key),
+		})
+	}
+	return batchDictionaryItems
+}
+
 // flattenDictionaryItems models data into format suitable for saving to Terraform state.
 func flattenDictionaryItems(remoteState []*gofastly.DictionaryItem) map[string]string {
 	result := make(map[string]string)
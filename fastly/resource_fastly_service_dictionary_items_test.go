@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
 
 	gofastly "github.com/fastly/go-fastly/v9/fastly"
@@ -238,6 +239,91 @@ func TestAccFastlyServiceDictionaryItem_external_item_is_removed(t *testing.T) {
 	})
 }
 
+func TestAccFastlyServiceDictionaryItem_key_prefix(t *testing.T) {
+	var service gofastly.ServiceDetail
+
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	dictName := fmt.Sprintf("dict %s", acctest.RandString(10))
+
+	items := map[string]string{
+		"team_a_key1": "value1",
+	}
+
+	config := strings.Replace(
+		testAccServiceDictionaryItemsConfigOneDictionaryWithItems(name, dictName, items, true, false),
+		"manage_items = false",
+		"manage_items = false\n    key_prefix = \"team_a_\"",
+		1,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckServiceVCLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists("fastly_service_vcl.foo", &service),
+					testAccCheckFastlyServiceDictionaryItemsRemoteState(&service, name, dictName, items),
+				),
+			},
+			{
+				// An item outside of the prefix is left alone, an item within it
+				// is removed.
+				PreConfig: func() {
+					createDictionaryItemThroughAPI(t, &service, dictName, "team_b_key", "value2")
+					createDictionaryItemThroughAPI(t, &service, dictName, "team_a_key2", "value3")
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists("fastly_service_vcl.foo", &service),
+					testAccCheckFastlyServiceDictionaryItemsRemoteState(&service, name, dictName, map[string]string{
+						"team_a_key1": "value1",
+						"team_b_key":  "value2",
+					}),
+					resource.TestCheckResourceAttr("fastly_service_dictionary_items.items", "items.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestCheckKeyPrefix(t *testing.T) {
+	if err := checkKeyPrefix("items", []string{"a_1", "a_2"}, "a_"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	err := checkKeyPrefix("items", []string{"a_1", "c", "b"}, "a_")
+	if err == nil || !strings.Contains(err.Error(), "b, c") {
+		t.Errorf("expected error naming the invalid keys, got %v", err)
+	}
+}
+
+func TestBuildDictionaryItemsDeletes(t *testing.T) {
+	// The state may still hold items of other teams, read before the prefix
+	// was set.
+	items := map[string]any{
+		"team_a_key1": "value1",
+		"team_a_key2": "value2",
+		"team_b_key":  "value3",
+	}
+
+	var got []string
+	for _, item := range buildDictionaryItemsDeletes(items, "team_a_") {
+		got = append(got, fmt.Sprintf("%s %s", gofastly.ToValue(item.Operation), gofastly.ToValue(item.ItemKey)))
+	}
+	want := []string{"delete team_a_key1", "delete team_a_key2"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want %#v, got %#v", want, got)
+	}
+
+	if got := buildDictionaryItemsDeletes(items, ""); len(got) != 3 {
+		t.Errorf("expected every item to be deleted without a prefix, got %d", len(got))
+	}
+}
+
 func TestAccFastlyServiceDictionaryItem_external_item_deleted(t *testing.T) {
 	var service gofastly.ServiceDetail
 
@@ -126,6 +126,48 @@ func TestAccFastlyServiceVCL_updateBackend(t *testing.T) {
 	})
 }
 
+func TestAccFastlyServiceVCL_purgeOnActivate(t *testing.T) {
+	var service gofastly.ServiceDetail
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domain := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+	backendName := fmt.Sprintf("%s.aws.amazon.com", acctest.RandString(3))
+	backendName2 := fmt.Sprintf("%s.aws.amazon.com", acctest.RandString(3))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckServiceVCLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceVCLConfigPurgeOnActivate(name, "comment", domain, backendName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists("fastly_service_vcl.foo", &service),
+					resource.TestCheckResourceAttr("fastly_service_vcl.foo", "active_version", "1"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.foo", "purge_on_activate.0.surrogate_keys.#", "2"),
+				),
+			},
+			{
+				// Changing only the purge settings and a versionless field must not create a new version.
+				Config: testAccServiceVCLConfigPurgeOnActivate(name, "updated comment", domain, backendName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_vcl.foo", "active_version", "1"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.foo", "purge_on_activate.0.soft", "true"),
+				),
+			},
+			{
+				Config: testAccServiceVCLConfigPurgeOnActivate(name, "updated comment", domain, backendName2, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists("fastly_service_vcl.foo", &service),
+					testAccCheckFastlyServiceVCLAttributesBackends(&service, name, []string{backendName2}),
+					resource.TestCheckResourceAttr("fastly_service_vcl.foo", "active_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 // TestAccFastlyServiceVCL_activateNewVersionExternally tests whether things break when a new version is cloned and
 // activated outside of Terraform. There has been a bug where the version used for reading the state, and the version
 // that gets cloned in order to make updates, are different when a new version is activated externally. In this case, a
@@ -870,6 +912,31 @@ resource "fastly_service_vcl" "foo" {
 }`, name, domain, backend)
 }
 
+func testAccServiceVCLConfigPurgeOnActivate(name, comment, domain, backend string, soft bool) string {
+	return fmt.Sprintf(`
+resource "fastly_service_vcl" "foo" {
+  name    = "%s"
+  comment = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  backend {
+    address = "%s"
+    name    = "tf -test backend"
+  }
+
+  purge_on_activate {
+    surrogate_keys = ["homepage", "product-listing"]
+    soft           = %t
+  }
+
+  force_destroy = true
+}`, name, comment, domain, backend, soft)
+}
+
 func testAccServiceVCLConfigStaticBackend(name, domain, snippet string) string {
 	return fmt.Sprintf(`
 resource "fastly_service_vcl" "foo" {
@@ -1,10 +1,17 @@
 package fastly
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
 	"fmt"
 	"log"
 	"sort"
+	"time"
 
 	gofastly "github.com/fastly/go-fastly/v9/fastly"
 	"github.com/hashicorp/go-cty/cty"
@@ -21,6 +28,7 @@ func resourceFastlyTLSMutualAuthentication() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceFastlyTLSMutualAuthenticationCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"activation_ids": {
 				Type:        schema.TypeSet,
@@ -31,9 +39,26 @@ func resourceFastlyTLSMutualAuthentication() *schema.Resource {
 				},
 			},
 			"cert_bundle": {
-				Type:        schema.TypeString,
-				Description: "One or more certificates. Enter each individual certificate blob on a new line. Must be PEM-formatted.",
-				Required:    true,
+				Type:             schema.TypeString,
+				Description:      "One or more certificates. Enter each individual certificate blob on a new line. Must be PEM-formatted. Each certificate must be a CA certificate and may only appear once in the bundle. When the bundle changes, every certificate must be unexpired.",
+				Required:         true,
+				ValidateDiagFunc: validateCertBundle(),
+			},
+			"cert_bundle_fingerprints": {
+				Type:        schema.TypeList,
+				Description: "The SHA-256 fingerprints (lowercase hex) of the certificates in `cert_bundle`, in bundle order.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"cert_bundle_subjects": {
+				Type:        schema.TypeList,
+				Description: "The subject distinguished names of the certificates in `cert_bundle`, in bundle order.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
 			},
 			"created_at": {
 				Type:        schema.TypeString,
@@ -157,6 +182,21 @@ func resourceFastlyTLSMutualAuthenticationRead(_ context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
+	// The bundle isn't returned by the API, so the computed attributes can
+	// only be derived from the bundle held in state (e.g. not after import).
+	if bundle := d.Get("cert_bundle").(string); bundle != "" {
+		subjects, fingerprints, err := flattenCertBundle(bundle)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("cert_bundle_subjects", subjects); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("cert_bundle_fingerprints", fingerprints); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	var activations []string
 	for _, a := range tma.Activations {
 		activations = append(activations, a.ID)
@@ -174,31 +214,37 @@ func resourceFastlyTLSMutualAuthenticationRead(_ context.Context, d *schema.Reso
 func resourceFastlyTLSMutualAuthenticationUpdate(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	conn := meta.(*APIClient).conn
 
-	input := &gofastly.UpdateTLSMutualAuthenticationInput{
-		ID:         d.Id(),
-		CertBundle: d.Get("cert_bundle").(string),
-	}
+	if d.HasChanges("cert_bundle", "enforced", "name") {
+		input := &gofastly.UpdateTLSMutualAuthenticationInput{
+			ID:         d.Id(),
+			CertBundle: d.Get("cert_bundle").(string),
+		}
 
-	// Since a boolean value is not 'optional', the input struct
-	// must always contain the expected value of the 'enforced'
-	// setting, whether it was changed or not
-	input.Enforced = d.Get("enforced").(bool)
+		// Since a boolean value is not 'optional', the input struct
+		// must always contain the expected value of the 'enforced'
+		// setting, whether it was changed or not
+		input.Enforced = d.Get("enforced").(bool)
 
-	if d.HasChange("name") {
-		input.Name = d.Get("name").(string)
-	}
+		if d.HasChange("name") {
+			input.Name = d.Get("name").(string)
+		}
 
-	log.Printf("[DEBUG] UPDATE: TLS Mutual Authentication input: %#v", input)
+		log.Printf("[DEBUG] UPDATE: TLS Mutual Authentication input: %#v", input)
 
-	_, err := conn.UpdateTLSMutualAuthentication(input)
-	if err != nil {
-		return diag.FromErr(err)
+		_, err := conn.UpdateTLSMutualAuthentication(input)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	if d.HasChange("activation_ids") {
-		// First unset mTLS from the old TLS Activations.
-		old, _ := d.GetChange("activation_ids")
-		for _, id := range old.(*schema.Set).List() {
+		// Only the TLS Activations that were added or removed need to be
+		// touched, the activations present in both sets are left as they are.
+		o, n := d.GetChange("activation_ids")
+		oldSet, newSet := o.(*schema.Set), n.(*schema.Set)
+
+		// First unset mTLS from the removed TLS Activations.
+		for _, id := range oldSet.Difference(newSet).List() {
 			input := &gofastly.UpdateTLSActivationInput{
 				ID:                   id.(string),
 				MutualAuthentication: &gofastly.TLSMutualAuthentication{ID: ""},
@@ -207,14 +253,14 @@ func resourceFastlyTLSMutualAuthenticationUpdate(_ context.Context, d *schema.Re
 			_, _ = conn.UpdateTLSActivation(input)
 		}
 
-		// Once old Activations have mTLS unset, set mTLS on the new Activations.
-		for _, id := range d.Get("activation_ids").(*schema.Set).List() {
+		// Once removed Activations have mTLS unset, set mTLS on the added Activations.
+		for _, id := range newSet.Difference(oldSet).List() {
 			inputUpdate := &gofastly.UpdateTLSActivationInput{
 				ID:                   id.(string),
 				MutualAuthentication: &gofastly.TLSMutualAuthentication{ID: d.Id()},
 			}
 			log.Printf("[DEBUG] UPDATE: Update TLS Activation input: %#v", inputUpdate)
-			_, err = conn.UpdateTLSActivation(inputUpdate)
+			_, err := conn.UpdateTLSActivation(inputUpdate)
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -255,3 +301,116 @@ func resourceFastlyTLSMutualAuthenticationDelete(_ context.Context, d *schema.Re
 	}
 	return nil
 }
+
+// resourceFastlyTLSMutualAuthenticationCustomizeDiff computes the bundle
+// subjects and fingerprints at plan time so that changes to the bundle are
+// visible before apply.
+func resourceFastlyTLSMutualAuthenticationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.HasChange("cert_bundle") {
+		return nil
+	}
+	if !d.NewValueKnown("cert_bundle") {
+		if err := d.SetNewComputed("cert_bundle_subjects"); err != nil {
+			return err
+		}
+		return d.SetNewComputed("cert_bundle_fingerprints")
+	}
+
+	// Expired certificates are only rejected when the bundle changes, so that
+	// a CA expiring does not break the plans of unrelated changes.
+	certs, err := parseCertBundle(d.Get("cert_bundle").(string))
+	if err != nil {
+		return err
+	}
+	if err := checkCertBundleExpiry(certs, time.Now()); err != nil {
+		return fmt.Errorf("invalid cert_bundle: %w", err)
+	}
+
+	subjects, fingerprints, err := flattenCertBundle(d.Get("cert_bundle").(string))
+	if err != nil {
+		return err
+	}
+	if err := d.SetNew("cert_bundle_subjects", subjects); err != nil {
+		return err
+	}
+	return d.SetNew("cert_bundle_fingerprints", fingerprints)
+}
+
+// flattenCertBundle returns the subjects and SHA-256 fingerprints of the
+// certificates in a PEM-formatted bundle.
+func flattenCertBundle(bundle string) (subjects []string, fingerprints []string, err error) {
+	certs, err := decodeCertBundle(bundle)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, c := range certs {
+		sum := sha256.Sum256(c.Raw)
+		subjects = append(subjects, c.Subject.String())
+		fingerprints = append(fingerprints, hex.EncodeToString(sum[:]))
+	}
+	return subjects, fingerprints, nil
+}
+
+// parseCertBundle decodes a PEM-formatted bundle and checks that every block
+// is a CA certificate that only appears once. Expiry is checked separately by
+// checkCertBundleExpiry, as it depends on the time of the plan.
+func parseCertBundle(bundle string) ([]*x509.Certificate, error) {
+	certs, err := decodeCertBundle(bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, c := range certs {
+		if !c.IsCA {
+			return nil, fmt.Errorf("certificate %d (%s) is not a CA certificate", i+1, c.Subject)
+		}
+		for j := 0; j < i; j++ {
+			if bytes.Equal(certs[j].Raw, c.Raw) {
+				return nil, fmt.Errorf("certificate %d (%s) is a duplicate of certificate %d", i+1, c.Subject, j+1)
+			}
+		}
+	}
+
+	return certs, nil
+}
+
+// checkCertBundleExpiry checks that every certificate is still valid at `now`.
+func checkCertBundleExpiry(certs []*x509.Certificate, now time.Time) error {
+	for i, c := range certs {
+		if now.After(c.NotAfter) {
+			return fmt.Errorf("certificate %d (%s) expired at %s", i+1, c.Subject, c.NotAfter.Format(time.RFC3339))
+		}
+	}
+	return nil
+}
+
+// decodeCertBundle decodes every PEM block of a bundle into a certificate.
+func decodeCertBundle(bundle string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+
+	rest := []byte(bundle)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("block %d is of type '%s', expected 'CERTIFICATE'", len(certs)+1, block.Type)
+		}
+		c, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("block %d is not a valid certificate: %w", len(certs)+1, err)
+		}
+		certs = append(certs, c)
+	}
+
+	if len(certs) == 0 {
+		return nil, errors.New("no PEM-format certificates found")
+	}
+	if len(bytes.TrimSpace(rest)) != 0 {
+		return nil, errors.New("unexpected trailing data after the last PEM-format certificate")
+	}
+
+	return certs, nil
+}
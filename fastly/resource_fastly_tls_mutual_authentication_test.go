@@ -1,15 +1,48 @@
 package fastly
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/pem"
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/stretchr/testify/require"
 )
 
+func TestParseCertBundle(t *testing.T) {
+	caCert, ca, _, err := buildCACertificate("example.com")
+	require.NoError(t, err)
+
+	certs, err := parseCertBundle(ca)
+	require.NoError(t, err)
+	require.Len(t, certs, 1)
+
+	require.NoError(t, checkCertBundleExpiry(certs, time.Now()))
+	require.ErrorContains(t, checkCertBundleExpiry(certs, caCert.NotAfter.Add(time.Second)), "expired")
+}
+
+func TestFlattenCertBundle(t *testing.T) {
+	_, ca, _, err := buildCACertificate()
+	require.NoError(t, err)
+	_, ca2, _, err := buildCACertificate()
+	require.NoError(t, err)
+
+	subjects, fingerprints, err := flattenCertBundle(fmt.Sprintf("%s\n%s", ca, ca2))
+	require.NoError(t, err)
+	require.Len(t, subjects, 2)
+	require.Len(t, fingerprints, 2)
+	require.NotEqual(t, fingerprints[0], fingerprints[1])
+
+	block, _ := pem.Decode([]byte(ca2))
+	sum := sha256.Sum256(block.Bytes)
+	require.Equal(t, hex.EncodeToString(sum[:]), fingerprints[1])
+}
+
 func TestAccFastlyMTLS_basic(t *testing.T) {
 	domain := fmt.Sprintf("%s.com", acctest.RandomWithPrefix(testResourcePrefix))
 	key, cert, cert2, err := generateKeyAndMultipleCerts(domain)
@@ -45,6 +78,8 @@ func TestAccFastlyMTLS_basic(t *testing.T) {
 					testAccFastlyTLSActivationCheckExists(resourceTLSActivationName),
 					resource.TestCheckResourceAttr(resourceMTLSName, "name", name),
 					resource.TestCheckResourceAttr(resourceMTLSName, "enforced", fmt.Sprintf("%t", enforced)),
+					resource.TestCheckResourceAttr(resourceMTLSName, "cert_bundle_subjects.#", "1"),
+					resource.TestCheckResourceAttr(resourceMTLSName, "cert_bundle_fingerprints.#", "1"),
 				),
 			},
 			{
@@ -65,7 +100,7 @@ func TestAccFastlyMTLS_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				// These attributes are not stored on the Fastly API and must be ignored.
-				ImportStateVerifyIgnore: []string{"cert_bundle", "activation_id"},
+				ImportStateVerifyIgnore: []string{"cert_bundle", "cert_bundle_fingerprints", "cert_bundle_subjects", "activation_id"},
 			},
 		},
 	})
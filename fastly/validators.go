@@ -3,7 +3,9 @@ package fastly
 import (
 	"encoding/pem"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	gofastly "github.com/fastly/go-fastly/v9/fastly"
 	"github.com/hashicorp/go-cty/cty"
@@ -126,16 +128,34 @@ func validateDictionaryItems() schema.SchemaValidateDiagFunc {
 	})
 }
 
+// serviceAuthorizationPermissions are the permissions a service authorization
+// can grant.
+var serviceAuthorizationPermissions = []string{
+	"full",
+	"read_only",
+	"purge_select",
+	"purge_all",
+}
+
 func validateServiceAuthorizationPermission() schema.SchemaValidateDiagFunc {
-	return validation.ToDiagFunc(validation.StringInSlice(
-		[]string{
-			"full",
-			"read_only",
-			"purge_select",
-			"purge_all",
-		},
-		false,
-	))
+	return validation.ToDiagFunc(validation.StringInSlice(serviceAuthorizationPermissions, false))
+}
+
+// validateServiceAuthorizationPermissions returns a schema validation function
+// that checks whether every value of a map is a service authorization
+// permission.
+func validateServiceAuthorizationPermissions() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(func(val any, key string) ([]string, []error) {
+		var errs []error
+		m := val.(map[string]any)
+		for _, user := range sortedKeys(m) {
+			permission, _ := m[user].(string)
+			if !slices.Contains(serviceAuthorizationPermissions, permission) {
+				errs = append(errs, fmt.Errorf("expected %s[%q] to be one of %s, got %s", key, user, strings.Join(serviceAuthorizationPermissions, ", "), permission))
+			}
+		}
+		return nil, errs
+	})
 }
 
 func validateUserRole() schema.SchemaValidateDiagFunc {
@@ -150,6 +170,44 @@ func validateUserRole() schema.SchemaValidateDiagFunc {
 	))
 }
 
+func validateTokenScope() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(validation.StringInSlice(
+		[]string{
+			string(gofastly.GlobalScope),
+			string(gofastly.GlobalReadScope),
+			string(gofastly.PurgeSelectScope),
+			string(gofastly.PurgeAllScope),
+		},
+		false,
+	))
+}
+
+func validateAutomationTokenRole() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(validation.StringInSlice(
+		[]string{
+			string(gofastly.BillingRole),
+			string(gofastly.EngineerRole),
+			string(gofastly.UserRole),
+		},
+		false,
+	))
+}
+
+// validateDuration returns a schema validation function that checks whether a
+// string is a positive Go duration (e.g. `720h`).
+func validateDuration() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(func(val any, key string) ([]string, []error) {
+		d, err := time.ParseDuration(val.(string))
+		if err != nil {
+			return nil, []error{fmt.Errorf("expected %s to be a valid duration (e.g. 720h): %w", key, err)}
+		}
+		if d <= 0 {
+			return nil, []error{fmt.Errorf("expected %s to be a positive duration, got %s", key, val)}
+		}
+		return nil, nil
+	})
+}
+
 // validatePEMBlock returns a schema validation function that checks whether a string contains a single PEM block of
 // type `pemType`.
 func validatePEMBlock(pemType string) schema.SchemaValidateDiagFunc {
@@ -194,6 +252,48 @@ func validatePEMBlocks(pemType string) schema.SchemaValidateDiagFunc {
 	})
 }
 
+// validateCertBundle returns a schema validation function that checks whether a string contains a bundle of unique CA
+// certificates. Expiry is checked at plan time when the bundle changes.
+func validateCertBundle() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(func(val any, key string) ([]string, []error) {
+		if _, err := parseCertBundle(val.(string)); err != nil {
+			return nil, []error{fmt.Errorf("expected %s to be a valid CA certificate bundle: %w", key, err)}
+		}
+		return nil, nil
+	})
+}
+
+// validateACLCIDR returns a schema validation function that checks whether a
+// string is an IPv4 or IPv6 address or CIDR, optionally negated with a leading
+// `!`.
+func validateACLCIDR() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(func(val any, key string) ([]string, []error) {
+		if _, _, err := parseACLCIDR(val.(string)); err != nil {
+			return nil, []error{fmt.Errorf("expected %s to be an IP address or CIDR: %w", key, err)}
+		}
+		return nil, nil
+	})
+}
+
+// validateDashboardJSON returns a schema validation function that checks whether
+// a string is a dashboard JSON document whose items each have a data source and
+// visualization type.
+func validateDashboardJSON() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(func(val any, key string) ([]string, []error) {
+		items, err := parseDashboardJSON(val.(string))
+		if err != nil {
+			return nil, []error{fmt.Errorf("expected %s to be a dashboard JSON document: %w", key, err)}
+		}
+		var errs []error
+		for i, item := range items {
+			if item.DataSource.Type == "" || item.Visualization.Type == "" || item.Visualization.Config.PlotType == "" {
+				errs = append(errs, fmt.Errorf("expected item #%d of %s to set `data_source.type`, `visualization.type` and `visualization.config.plot_type`", i, key))
+			}
+		}
+		return nil, errs
+	})
+}
+
 func validateStringTrimmed(i any, path cty.Path) diag.Diagnostics {
 	v := i.(string)
 	attr := path[len(path)-1].(cty.GetAttrStep)
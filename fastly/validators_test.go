@@ -3,6 +3,7 @@ package fastly
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/go-cty/cty"
 
@@ -367,6 +368,27 @@ func TestValidateUserRole(t *testing.T) {
 	}
 }
 
+func TestValidateServiceAuthorizationPermissions(t *testing.T) {
+	for name, testcase := range map[string]struct {
+		value          map[string]any
+		expectedErrors int
+	}{
+		"empty":   {map[string]any{}, 0},
+		"valid":   {map[string]any{"u1": "full", "u2": "read_only", "u3": "purge_select", "u4": "purge_all"}, 0},
+		"invalid": {map[string]any{"u1": "full", "u2": "admin", "u3": "FULL"}, 2},
+	} {
+		t.Run(name, func(t *testing.T) {
+			actualWarns, actualErrors := diagToWarnsAndErrs(validateServiceAuthorizationPermissions()(testcase.value, cty.GetAttrPath("permissions")))
+			if len(actualWarns) != 0 {
+				t.Errorf("expected 0 warnings, actual %d ", len(actualWarns))
+			}
+			if len(actualErrors) != testcase.expectedErrors {
+				t.Errorf("expected %d errors, actual %d ", testcase.expectedErrors, len(actualErrors))
+			}
+		})
+	}
+}
+
 func TestValidatePEMCertificate(t *testing.T) {
 	key, cert, ca, err := generateKeyAndCertWithCA()
 	if err != nil {
@@ -429,3 +451,130 @@ func TestValidateMultiplePEMBlocks(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateCertBundle(t *testing.T) {
+	key, cert, ca, err := generateKeyAndCertWithCA()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, ca2, _, err := buildCACertificate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Expiry is checked at plan time when the bundle changes, not here.
+	expiredTemplate, _, privateKey, err := buildCACertificate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expiredTemplate.NotBefore = time.Now().Add(-48 * time.Hour)
+	expiredTemplate.NotAfter = time.Now().Add(-24 * time.Hour)
+	expired, err := formatCertificate(expiredTemplate, expiredTemplate, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, testCase := range map[string]struct {
+		value            string
+		expectedWarnings int
+		expectedErrors   int
+	}{
+		"single CA":           {ca, 0, 0},
+		"two CAs":             {fmt.Sprintf("%s\n%s", ca, ca2), 0, 0},
+		"expired CA":          {expired, 0, 0},
+		"duplicate CA":        {fmt.Sprintf("%s\n%s", ca, ca), 0, 1},
+		"leaf certificate":    {fmt.Sprintf("%s\n%s", ca, cert), 0, 1},
+		"private key":         {fmt.Sprintf("%s\n%s", ca, key), 0, 1},
+		"trailing gibberish":  {fmt.Sprintf("%s\nlshakdjf", ca), 0, 1},
+		"empty string":        {"", 0, 1},
+		"invalid certificate": {"-----BEGIN CERTIFICATE-----\ncafebabe\n-----END CERTIFICATE-----\n", 0, 1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			actualWarnings, actualErrors := diagToWarnsAndErrs(validateCertBundle()(testCase.value, cty.GetAttrPath("cert_bundle")))
+
+			if len(actualWarnings) != testCase.expectedWarnings {
+				t.Errorf("expected %d warnings, got %d", testCase.expectedWarnings, len(actualWarnings))
+			}
+			if len(actualErrors) != testCase.expectedErrors {
+				t.Errorf("expected %d errors, got %d: %v", testCase.expectedErrors, len(actualErrors), actualErrors)
+			}
+		})
+	}
+}
+
+func TestValidateDuration(t *testing.T) {
+	for _, testcase := range []struct {
+		value          string
+		expectedWarns  int
+		expectedErrors int
+	}{
+		{"720h", 0, 0},
+		{"1h30m", 0, 0},
+		{"0s", 0, 1},
+		{"-1h", 0, 1},
+		{"30d", 0, 1},
+		{"", 0, 1},
+	} {
+		t.Run(testcase.value, func(t *testing.T) {
+			actualWarns, actualErrors := diagToWarnsAndErrs(validateDuration()(testcase.value, cty.GetAttrPath("ttl")))
+			if len(actualWarns) != testcase.expectedWarns {
+				t.Errorf("expected %d warnings, actual %d ", testcase.expectedWarns, len(actualWarns))
+			}
+			if len(actualErrors) != testcase.expectedErrors {
+				t.Errorf("expected %d errors, actual %d ", testcase.expectedErrors, len(actualErrors))
+			}
+		})
+	}
+}
+
+func TestValidateACLCIDR(t *testing.T) {
+	for _, testcase := range []struct {
+		value          string
+		expectedWarns  int
+		expectedErrors int
+	}{
+		{"192.0.2.0/24", 0, 0},
+		{"192.0.2.1", 0, 0},
+		{"!192.0.2.0/24", 0, 0},
+		{"2001:db8::/32", 0, 0},
+		{"2001:db8::1", 0, 0},
+		{"192.0.2.0/33", 0, 1},
+		{"2001:db8::/129", 0, 1},
+		{"example.com", 0, 1},
+		{"", 0, 1},
+	} {
+		t.Run(testcase.value, func(t *testing.T) {
+			actualWarns, actualErrors := diagToWarnsAndErrs(validateACLCIDR()(testcase.value, cty.GetAttrPath("cidrs")))
+			if len(actualWarns) != testcase.expectedWarns {
+				t.Errorf("expected %d warnings, actual %d ", testcase.expectedWarns, len(actualWarns))
+			}
+			if len(actualErrors) != testcase.expectedErrors {
+				t.Errorf("expected %d errors, actual %d ", testcase.expectedErrors, len(actualErrors))
+			}
+		})
+	}
+}
+
+func TestValidateDashboardJSON(t *testing.T) {
+	for name, testcase := range map[string]struct {
+		value          string
+		expectedWarns  int
+		expectedErrors int
+	}{
+		"items":          {`[{"data_source":{"type":"stats.edge","config":{"metrics":["requests"]}},"visualization":{"type":"chart","config":{"plot_type":"line"}}}]`, 0, 0},
+		"document":       {`{"name":"x","items":[]}`, 0, 0},
+		"no items":       {`{"name":"x"}`, 0, 1},
+		"invalid JSON":   {`[{`, 0, 1},
+		"missing types":  {`[{"title":"a"},{"title":"b"}]`, 0, 2},
+		"wrong document": {`"items"`, 0, 1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			actualWarns, actualErrors := diagToWarnsAndErrs(validateDashboardJSON()(testcase.value, cty.GetAttrPath("dashboard_json")))
+			if len(actualWarns) != testcase.expectedWarns {
+				t.Errorf("expected %d warnings, actual %d ", testcase.expectedWarns, len(actualWarns))
+			}
+			if len(actualErrors) != testcase.expectedErrors {
+				t.Errorf("expected %d errors, actual %d ", testcase.expectedErrors, len(actualErrors))
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ go 1.22.0
 toolchain go1.22.11
 
 require (
+	github.com/BurntSushi/toml v1.4.1-0.20240526193622-a339e1f7089c
 	github.com/bflad/tfproviderlint v0.30.0
 	github.com/fastly/go-fastly/v9 v9.13.0
 	github.com/google/go-cmp v0.6.0
@@ -16,7 +17,6 @@ require (
 )
 
 require (
-	github.com/BurntSushi/toml v1.4.1-0.20240526193622-a339e1f7089c // indirect
 	github.com/Kunde21/markdownfmt/v3 v3.1.0 // indirect
 	github.com/Masterminds/goutils v1.1.1 // indirect
 	github.com/Masterminds/semver/v3 v3.2.0 // indirect
@@ -0,0 +1,22 @@
+#!/bin/bash
+
+# This script refreshes the snapshot of the Fastly datacenters embedded in the
+# provider, which is used to validate shield POPs when the API cannot be
+# reached. It needs a Fastly API token in FASTLY_API_KEY.
+
+set -e
+
+if [[ ! -f fastly/datacenters.json ]]; then
+  echo "ERROR: fastly/datacenters.json not found in pwd."
+  echo "Please run this from the root of the terraform provider repository"
+  exit 1
+fi
+
+if [[ -z "${FASTLY_API_KEY}" ]]; then
+  echo "ERROR: FASTLY_API_KEY is not set."
+  exit 1
+fi
+
+curl --silent --fail --header "Fastly-Key: ${FASTLY_API_KEY}" "${FASTLY_API_URL:-https://api.fastly.com}/datacenters" |
+  jq 'map({code, name, group, coordinates: {latitude: .coordinates.latitude, longitude: .coordinates.longitude}} + (if .shield then {shield} else {} end)) | sort_by(.code)' \
+  > fastly/datacenters.json